@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -18,8 +19,8 @@ type Account struct {
 
 type Balance struct {
 	Asset  string
-	Free   float64 `json:"free,string"`
-	Locked float64 `json:"locked,string"`
+	Free   Decimal `json:"free"`
+	Locked Decimal `json:"locked"`
 }
 
 type accountQuery struct {
@@ -37,12 +38,16 @@ func (r *accountQuery) RecvWindow(value int64) *accountQuery {
 }
 
 func (sdk Sdk) Account(query *accountQuery) (*Account, error) {
+	return sdk.AccountCtx(context.Background(), query)
+}
+
+func (sdk Sdk) AccountCtx(ctx context.Context, query *accountQuery) (*Account, error) {
 	req := newRequest("GET", "/api/v3/account").
 		Int64Param("recvWindow", query.recvWindow).
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func TestSdk_Account(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAccountJson(), nil)
 
@@ -53,7 +53,7 @@ func TestSdk_Account(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAccountJson(), nil)
 
@@ -78,7 +78,7 @@ func TestSdk_Account(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -103,7 +103,7 @@ func TestSdk_Account(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -152,12 +152,12 @@ func validAccountResponse() *Account {
 		Balances: []Balance{
 			{
 				Asset:  "BTC",
-				Free:   4723846.89208129,
-				Locked: 0,
+				Free:   MustDecimal("4723846.89208129"),
+				Locked: MustDecimal("0"),
 			}, {
 				Asset:  "LTC",
-				Free:   4763368.68006011,
-				Locked: 0,
+				Free:   MustDecimal("4763368.68006011"),
+				Locked: MustDecimal("0"),
 			},
 		},
 	}
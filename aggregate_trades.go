@@ -1,14 +1,15 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
 
 type CompressedTrade struct {
 	Id           int     `json:"a"`
-	Price        float64 `json:"p,string"`
-	Quantity     float64 `json:"q,string"`
+	Price        Decimal `json:"p"`
+	Quantity     Decimal `json:"q"`
 	FirstTradeId int     `json:"f"`
 	LastTradeId  int     `json:"l"`
 	Time         int     `json:"T"`
@@ -60,6 +61,10 @@ func parseCompressedTradesResponse(jsonContent []byte) ([]CompressedTrade, error
 }
 
 func (sdk *Sdk) CompressedTrades(query *compressedTradesQuery) ([]CompressedTrade, error) {
+	return sdk.CompressedTradesCtx(context.Background(), query)
+}
+
+func (sdk *Sdk) CompressedTradesCtx(ctx context.Context, query *compressedTradesQuery) ([]CompressedTrade, error) {
 	request := newRequest("GET", "/api/v1/aggTrades").Param("symbol", query.symbol)
 
 	if query.limit > 0 {
@@ -78,7 +83,7 @@ func (sdk *Sdk) CompressedTrades(query *compressedTradesQuery) ([]CompressedTrad
 		request.Param("endTime", strconv.Itoa(query.endTime))
 	}
 
-	responseContent, err := sdk.client.Do(request)
+	responseContent, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -17,7 +17,7 @@ func TestSdk_CompressedTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validCompressedTradesJson(), nil)
 
@@ -38,7 +38,7 @@ func TestSdk_CompressedTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validCompressedTradesJson(), nil)
 
@@ -55,7 +55,7 @@ func TestSdk_CompressedTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -71,7 +71,7 @@ func TestSdk_CompressedTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -99,8 +99,8 @@ func validCompressedTradesJson() []byte {
 func validCompressedTradesResponse() []CompressedTrade {
 	return []CompressedTrade{{
 		Id:           26129,
-		Price:        0.01633102,
-		Quantity:     4.70443515,
+		Price:        MustDecimal("0.01633102"),
+		Quantity:     MustDecimal("4.70443515"),
 		FirstTradeId: 27781,
 		LastTradeId:  27781,
 		Time:         1498793709153,
@@ -0,0 +1,63 @@
+package binance
+
+import "context"
+
+type getAllOrderListsQuery struct {
+	fromId     *int64
+	startTime  *int64
+	endTime    *int64
+	limit      *int64
+	recvWindow *int64
+	timestamp  *int64
+}
+
+func NewGetAllOrderListsQuery() *getAllOrderListsQuery {
+	return &getAllOrderListsQuery{}
+}
+
+func (r *getAllOrderListsQuery) FromId(value int64) *getAllOrderListsQuery {
+	r.fromId = &value
+	return r
+}
+
+func (r *getAllOrderListsQuery) StartTime(value int64) *getAllOrderListsQuery {
+	r.startTime = &value
+	return r
+}
+
+func (r *getAllOrderListsQuery) EndTime(value int64) *getAllOrderListsQuery {
+	r.endTime = &value
+	return r
+}
+
+func (r *getAllOrderListsQuery) Limit(value int64) *getAllOrderListsQuery {
+	r.limit = &value
+	return r
+}
+
+func (r *getAllOrderListsQuery) RecvWindow(value int64) *getAllOrderListsQuery {
+	r.recvWindow = &value
+	return r
+}
+
+func (sdk Sdk) GetAllOrderLists(query *getAllOrderListsQuery) ([]OrderList, error) {
+	return sdk.GetAllOrderListsCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetAllOrderListsCtx(ctx context.Context, query *getAllOrderListsQuery) ([]OrderList, error) {
+	req := newRequest("GET", "/api/v3/allOrderList").
+		Int64Param("fromId", query.fromId).
+		Int64Param("startTime", query.startTime).
+		Int64Param("endTime", query.endTime).
+		Int64Param("limit", query.limit).
+		Int64Param("recvWindow", query.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListsResponse(responseContent)
+}
@@ -0,0 +1,121 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_GetAllOrderLists(t *testing.T) {
+	method, url := "GET", "/api/v3/allOrderList"
+
+	t.Run("It should convert api response to a list of OrderList", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListsJson(), nil)
+
+		response, _ := sdk.GetAllOrderLists(NewGetAllOrderListsQuery())
+
+		assert.Equal(t, validOrderListsResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("fromId", "20").
+			Param("startTime", "1565245656000").
+			Param("endTime", "1565245657000").
+			Param("limit", "10").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListsJson(), nil)
+
+		query := NewGetAllOrderListsQuery().
+			FromId(20).
+			StartTime(1565245656000).
+			EndTime(1565245657000).
+			Limit(10).
+			RecvWindow(2)
+		response, _ := sdk.GetAllOrderLists(query)
+
+		assert.Equal(t, validOrderListsResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.GetAllOrderLists(NewGetAllOrderListsQuery())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.GetAllOrderLists(NewGetAllOrderListsQuery())
+
+		assert.Error(t, err)
+	})
+}
+
+func validOrderListsJson() []byte {
+	return []byte(`[` + string(validOrderListJson()) + `]`)
+}
+
+func validOrderListsResponse() []OrderList {
+	return []OrderList{*validOrderListResponse()}
+}
@@ -1,5 +1,7 @@
 package binance
 
+import "context"
+
 type getAllOrdersQuery struct {
 	symbol     *string
 	orderId    *int64
@@ -30,6 +32,10 @@ func (r *getAllOrdersQuery) RecvWindow(value int64) *getAllOrdersQuery {
 }
 
 func (sdk Sdk) GetAllOrders(query *getAllOrdersQuery) ([]Order, error) {
+	return sdk.GetAllOrdersCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetAllOrdersCtx(ctx context.Context, query *getAllOrdersQuery) ([]Order, error) {
 	req := newRequest("GET", "/api/v3/allOrders").
 		StringParam("symbol", query.symbol).
 		Int64Param("orderId", query.orderId).
@@ -38,7 +44,7 @@ func (sdk Sdk) GetAllOrders(query *getAllOrdersQuery) ([]Order, error) {
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func TestSdk_GetAllOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAllOrdersJson(), nil)
 
@@ -57,7 +57,7 @@ func TestSdk_GetAllOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validOpenOrdersJson(), nil)
 
@@ -86,7 +86,7 @@ func TestSdk_GetAllOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -112,7 +112,7 @@ func TestSdk_GetAllOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -147,15 +147,15 @@ func validAllOrdersResponse() []Order {
 		Symbol:           "LTCBTC",
 		OrderId:          1,
 		ClientOrderId:    "myOrder1",
-		Price:            0.1,
-		OriginalQuantity: 1,
-		ExecutedQuantity: 0,
+		Price:            MustDecimal("0.1"),
+		OriginalQuantity: MustDecimal("1"),
+		ExecutedQuantity: MustDecimal("0"),
 		Status:           "NEW",
 		TimeInForce:      "GTC",
 		Type:             "LIMIT",
 		Side:             "BUY",
-		StopPrice:        0.0,
-		IcebergQuantity:  0.0,
+		StopPrice:        MustDecimal("0.0"),
+		IcebergQuantity:  MustDecimal("0.0"),
 		Time:             1499827319559,
 		IsWorking:        true,
 	}}
@@ -0,0 +1,83 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Result of AmendOrderKeepPriority. ListStatus is only set when the order belongs to an order list.
+type AmendKeepPriorityResult struct {
+	TransactionTime int64        `json:"transactTime"`
+	ExecutionId     int64        `json:"executionId"`
+	AmendedOrder    AmendedOrder `json:"amendedOrder"`
+	ListStatus      *OrderList   `json:"listStatus"`
+}
+
+type AmendedOrder struct {
+	Symbol                  string                  `json:"symbol"`
+	OrderId                 int64                   `json:"orderId"`
+	OrderListId             int64                   `json:"orderListId"`
+	OrigClientOrderId       string                  `json:"origClientOrderId"`
+	ClientOrderId           string                  `json:"clientOrderId"`
+	Price                   Decimal                 `json:"price"`
+	Quantity                Decimal                 `json:"qty"`
+	ExecutedQuantity        Decimal                 `json:"executedQty"`
+	PreventedQuantity       Decimal                 `json:"preventedQty"`
+	QuoteOrderQuantity      Decimal                 `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity Decimal                 `json:"cumulativeQuoteQty"`
+	Status                  OrderStatus             `json:"status"`
+	TimeInForce             TimeInForce             `json:"timeInForce"`
+	Type                    OrderType               `json:"type"`
+	Side                    OrderSide               `json:"side"`
+	WorkingTime             int64                   `json:"workingTime"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+type amendOrderKeepPriorityRequest struct {
+	order            *getOrderQuery
+	newQuantity      *Decimal
+	newClientOrderId *string
+}
+
+// Reduces the quantity of the order identified by the query, which keeps its priority in the order book. The recv
+// window of the query is used for the request.
+func NewAmendOrderKeepPriorityRequest(order *getOrderQuery, newQuantity Decimal) *amendOrderKeepPriorityRequest {
+	return &amendOrderKeepPriorityRequest{
+		order:       order,
+		newQuantity: &newQuantity,
+	}
+}
+
+func (r *amendOrderKeepPriorityRequest) NewClientOrderId(value string) *amendOrderKeepPriorityRequest {
+	r.newClientOrderId = &value
+	return r
+}
+
+func (sdk Sdk) AmendOrderKeepPriority(request *amendOrderKeepPriorityRequest) (*AmendKeepPriorityResult, error) {
+	return sdk.AmendOrderKeepPriorityCtx(context.Background(), request)
+}
+
+func (sdk Sdk) AmendOrderKeepPriorityCtx(ctx context.Context, request *amendOrderKeepPriorityRequest) (*AmendKeepPriorityResult, error) {
+	req := newRequest("PUT", "/api/v3/order/amend/keepPriority").
+		StringParam("symbol", request.order.symbol).
+		Int64Param("orderId", request.order.orderId).
+		StringParam("origClientOrderId", request.order.origClientOrderId).
+		StringParam("newClientOrderId", request.newClientOrderId).
+		DecimalParam("newQty", request.newQuantity).
+		Int64Param("recvWindow", request.order.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAmendKeepPriorityResponse(responseContent)
+}
+
+func parseAmendKeepPriorityResponse(jsonContent []byte) (*AmendKeepPriorityResult, error) {
+	response := &AmendKeepPriorityResult{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
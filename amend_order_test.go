@@ -0,0 +1,163 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_AmendOrderKeepPriority(t *testing.T) {
+	method, url := "PUT", "/api/v3/order/amend/keepPriority"
+
+	t.Run("It should convert api response to an AmendKeepPriorityResult", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("orderId", "9").
+			Param("newQty", "5").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validAmendKeepPriorityJson(), nil)
+
+		request := NewAmendOrderKeepPriorityRequest(NewGetOrderQuery("BTCUSDT").OrderId(9), MustDecimal("5"))
+		response, _ := sdk.AmendOrderKeepPriority(request)
+
+		assert.Equal(t, validAmendKeepPriorityResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("origClientOrderId", "original").
+			Param("newClientOrderId", "amended").
+			Param("newQty", "5").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validAmendKeepPriorityJson(), nil)
+
+		query := NewGetOrderQuery("BTCUSDT").OrigClientOrderId("original").RecvWindow(2)
+		request := NewAmendOrderKeepPriorityRequest(query, MustDecimal("5")).NewClientOrderId("amended")
+		response, _ := sdk.AmendOrderKeepPriority(request)
+
+		assert.Equal(t, validAmendKeepPriorityResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		request := NewAmendOrderKeepPriorityRequest(NewGetOrderQuery("BTCUSDT").OrderId(9), MustDecimal("5"))
+		_, err := sdk.AmendOrderKeepPriority(request)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		request := NewAmendOrderKeepPriorityRequest(NewGetOrderQuery("BTCUSDT").OrderId(9), MustDecimal("5"))
+		_, err := sdk.AmendOrderKeepPriority(request)
+
+		assert.Error(t, err)
+	})
+}
+
+func validAmendKeepPriorityJson() []byte {
+	return []byte(`{
+		"transactTime": 1741926410255,
+		"executionId": 75,
+		"amendedOrder": {
+			"symbol": "BTCUSDT",
+			"orderId": 9,
+			"orderListId": -1,
+			"origClientOrderId": "original",
+			"clientOrderId": "amended",
+			"price": "6.00000000",
+			"qty": "5.00000000",
+			"executedQty": "0.00000000",
+			"preventedQty": "0.00000000",
+			"quoteOrderQty": "0.00000000",
+			"cumulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"workingTime": 1741926410242,
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+}
+
+func validAmendKeepPriorityResponse() *AmendKeepPriorityResult {
+	return &AmendKeepPriorityResult{
+		TransactionTime: 1741926410255,
+		ExecutionId:     75,
+		AmendedOrder: AmendedOrder{
+			Symbol:                  "BTCUSDT",
+			OrderId:                 9,
+			OrderListId:             -1,
+			OrigClientOrderId:       "original",
+			ClientOrderId:           "amended",
+			Price:                   MustDecimal("6"),
+			Quantity:                MustDecimal("5"),
+			Status:                  OrderStatusNew,
+			TimeInForce:             TimeInForceGTC,
+			Type:                    OrderTypeLimit,
+			Side:                    SideSell,
+			WorkingTime:             1741926410242,
+			SelfTradePreventionMode: "NONE",
+		},
+	}
+}
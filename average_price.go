@@ -0,0 +1,45 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Average price of a symbol in the last Minutes minutes, which is the reference of the percent price filters and
+// of the notional of market orders.
+type AveragePrice struct {
+	Minutes   int     `json:"mins"`
+	Price     Decimal `json:"price"`
+	CloseTime int64   `json:"closeTime"`
+}
+
+type averagePriceQuery struct {
+	symbol string
+}
+
+// Required query for AveragePrice.
+func NewAveragePriceQuery(symbol string) *averagePriceQuery {
+	return &averagePriceQuery{symbol: symbol}
+}
+
+// Current average price for a symbol.
+func (sdk Sdk) AveragePrice(query *averagePriceQuery) (*AveragePrice, error) {
+	return sdk.AveragePriceCtx(context.Background(), query)
+}
+
+// Current average price for a symbol, cancelled when the given context is done.
+func (sdk Sdk) AveragePriceCtx(ctx context.Context, query *averagePriceQuery) (*AveragePrice, error) {
+	request := newRequest("GET", "/api/v3/avgPrice").Param("symbol", query.symbol)
+	response, err := sdk.client.Do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAveragePriceResponse(response)
+}
+
+func parseAveragePriceResponse(jsonContent []byte) (*AveragePrice, error) {
+	response := &AveragePrice{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
@@ -0,0 +1,79 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSdk_AveragePrice(t *testing.T) {
+	method, url := "GET", "/api/v3/avgPrice"
+
+	t.Run("It should convert api response to an AveragePrice", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "ETHBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validAveragePriceJson(), nil)
+
+		response, _ := sdk.AveragePrice(NewAveragePriceQuery("ETHBTC"))
+
+		assert.Equal(t, validAveragePriceResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "ETHBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.AveragePrice(NewAveragePriceQuery("ETHBTC"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "ETHBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.AveragePrice(NewAveragePriceQuery("ETHBTC"))
+
+		assert.Error(t, err)
+	})
+}
+
+func validAveragePriceJson() []byte {
+	return []byte(`{
+		"mins": 5,
+		"price": "0.05000000",
+		"closeTime": 1694061154503
+	}`)
+}
+
+func validAveragePriceResponse() *AveragePrice {
+	return &AveragePrice{
+		Minutes:   5,
+		Price:     MustDecimal("0.05"),
+		CloseTime: 1694061154503,
+	}
+}
@@ -0,0 +1,84 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Orders and order lists canceled by CancelAllOpenOrders. The orders of the lists are not included in Orders.
+type CancelledOpenOrders struct {
+	Orders     []OrderReport
+	OrderLists []OrderListReport
+}
+
+type cancelAllOpenOrdersRequest struct {
+	symbol     *string
+	recvWindow *int64
+	timestamp  *int64
+}
+
+func NewCancelAllOpenOrdersRequest(symbol string) *cancelAllOpenOrdersRequest {
+	return &cancelAllOpenOrdersRequest{
+		symbol: &symbol,
+	}
+}
+
+func (r *cancelAllOpenOrdersRequest) RecvWindow(value int64) *cancelAllOpenOrdersRequest {
+	r.recvWindow = &value
+	return r
+}
+
+// Cancels all the open orders and order lists of a symbol.
+func (sdk Sdk) CancelAllOpenOrders(request *cancelAllOpenOrdersRequest) (*CancelledOpenOrders, error) {
+	return sdk.CancelAllOpenOrdersCtx(context.Background(), request)
+}
+
+func (sdk Sdk) CancelAllOpenOrdersCtx(ctx context.Context, request *cancelAllOpenOrdersRequest) (*CancelledOpenOrders, error) {
+	req := newRequest("DELETE", "/api/v3/openOrders").
+		StringParam("symbol", request.symbol).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCancelledOpenOrdersResponse(responseContent)
+}
+
+// The api returns the canceled orders and order lists in the same array. Order lists are told apart by their
+// contingency type.
+func parseCancelledOpenOrdersResponse(jsonContent []byte) (*CancelledOpenOrders, error) {
+	items := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(jsonContent, &items); err != nil {
+		return nil, err
+	}
+
+	response := &CancelledOpenOrders{Orders: []OrderReport{}, OrderLists: []OrderListReport{}}
+	for _, item := range items {
+		kind := struct {
+			ContingencyType ContingencyType `json:"contingencyType"`
+		}{}
+		if err := json.Unmarshal(item, &kind); err != nil {
+			return nil, err
+		}
+
+		if kind.ContingencyType != "" {
+			orderList := OrderListReport{}
+			if err := json.Unmarshal(item, &orderList); err != nil {
+				return nil, err
+			}
+			response.OrderLists = append(response.OrderLists, orderList)
+			continue
+		}
+
+		order := OrderReport{}
+		if err := json.Unmarshal(item, &order); err != nil {
+			return nil, err
+		}
+		response.Orders = append(response.Orders, order)
+	}
+	return response, nil
+}
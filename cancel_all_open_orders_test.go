@@ -0,0 +1,155 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_CancelAllOpenOrders(t *testing.T) {
+	method, url := "DELETE", "/api/v3/openOrders"
+
+	t.Run("It should convert api response to CancelledOpenOrders", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validCancelledOpenOrdersJson(), nil)
+
+		response, _ := sdk.CancelAllOpenOrders(NewCancelAllOpenOrdersRequest("LTCBTC"))
+
+		assert.Equal(t, validCancelledOpenOrdersResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`[]`), nil)
+
+		response, _ := sdk.CancelAllOpenOrders(NewCancelAllOpenOrdersRequest("LTCBTC").RecvWindow(2))
+
+		assert.Equal(t, &CancelledOpenOrders{Orders: []OrderReport{}, OrderLists: []OrderListReport{}}, response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.CancelAllOpenOrders(NewCancelAllOpenOrdersRequest("LTCBTC"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.CancelAllOpenOrders(NewCancelAllOpenOrdersRequest("LTCBTC"))
+
+		assert.Error(t, err)
+	})
+}
+
+func validCancelledOpenOrdersJson() []byte {
+	return []byte(`[
+		{
+			"symbol": "LTCBTC",
+			"origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+			"orderId": 11,
+			"orderListId": -1,
+			"clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+			"transactTime": 1684804350068,
+			"price": "0.089853",
+			"origQty": "0.178622",
+			"executedQty": "0.000000",
+			"cummulativeQuoteQty": "0.000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"selfTradePreventionMode": "NONE"
+		},
+		` + string(validOrderListReportJson()) + `
+	]`)
+}
+
+func validCancelledOpenOrdersResponse() *CancelledOpenOrders {
+	return &CancelledOpenOrders{
+		Orders: []OrderReport{
+			{
+				FullOrder: FullOrder{OrderResult: OrderResult{
+					OrderAck: OrderAck{
+						Symbol:          "LTCBTC",
+						OrderId:         11,
+						OrderListId:     -1,
+						ClientOrderId:   "pXLV6Hz6mprAcVYpVMTGgx",
+						TransactionTime: 1684804350068,
+					},
+					Price:                   MustDecimal("0.089853"),
+					OriginalQuantity:        MustDecimal("0.178622"),
+					Status:                  OrderStatusCanceled,
+					TimeInForce:             TimeInForceGTC,
+					Type:                    OrderTypeLimit,
+					Side:                    SideBuy,
+					SelfTradePreventionMode: "NONE",
+				}},
+				OrigClientOrderId: "E6APeyTJvkMvLMYMqu1KQ4",
+			},
+		},
+		OrderLists: []OrderListReport{*validOrderListReportResponse()},
+	}
+}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -42,6 +43,10 @@ func (r *cancelOrderRequest) RecvWindow(value int64) *cancelOrderRequest {
 }
 
 func (sdk Sdk) CancelOrder(request *cancelOrderRequest) (*CancelledOrder, error) {
+	return sdk.CancelOrderCtx(context.Background(), request)
+}
+
+func (sdk Sdk) CancelOrderCtx(ctx context.Context, request *cancelOrderRequest) (*CancelledOrder, error) {
 	req := newRequest("DELETE", "/api/v3/order").
 		StringParam("symbol", request.symbol).
 		Int64Param("orderId", request.orderId).
@@ -50,7 +55,7 @@ func (sdk Sdk) CancelOrder(request *cancelOrderRequest) (*CancelledOrder, error)
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,62 @@
+package binance
+
+import "context"
+
+type cancelOrderListRequest struct {
+	symbol            *string
+	orderListId       *int64
+	listClientOrderId *string
+	newClientOrderId  *string
+	recvWindow        *int64
+	timestamp         *int64
+}
+
+// Either the order list id or the list client order id must be set.
+func NewCancelOrderListRequest(symbol string) *cancelOrderListRequest {
+	return &cancelOrderListRequest{
+		symbol: &symbol,
+	}
+}
+
+func (r *cancelOrderListRequest) OrderListId(value int64) *cancelOrderListRequest {
+	r.orderListId = &value
+	return r
+}
+
+func (r *cancelOrderListRequest) ListClientOrderId(value string) *cancelOrderListRequest {
+	r.listClientOrderId = &value
+	return r
+}
+
+func (r *cancelOrderListRequest) NewClientOrderId(value string) *cancelOrderListRequest {
+	r.newClientOrderId = &value
+	return r
+}
+
+func (r *cancelOrderListRequest) RecvWindow(value int64) *cancelOrderListRequest {
+	r.recvWindow = &value
+	return r
+}
+
+// Cancels all the orders of a list.
+func (sdk Sdk) CancelOrderList(request *cancelOrderListRequest) (*OrderListReport, error) {
+	return sdk.CancelOrderListCtx(context.Background(), request)
+}
+
+func (sdk Sdk) CancelOrderListCtx(ctx context.Context, request *cancelOrderListRequest) (*OrderListReport, error) {
+	req := newRequest("DELETE", "/api/v3/orderList").
+		StringParam("symbol", request.symbol).
+		Int64Param("orderListId", request.orderListId).
+		StringParam("listClientOrderId", request.listClientOrderId).
+		StringParam("newClientOrderId", request.newClientOrderId).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListReportResponse(responseContent)
+}
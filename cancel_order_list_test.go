@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_CancelOrderList(t *testing.T) {
+	method, url := "DELETE", "/api/v3/orderList"
+
+	t.Run("It should convert api response to an OrderListReport", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("orderListId", "27").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		response, _ := sdk.CancelOrderList(NewCancelOrderListRequest("LTCBTC").OrderListId(27))
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("listClientOrderId", "h2USkA5YQpaXHPIrkd96xE").
+			Param("newClientOrderId", "cancel").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		request := NewCancelOrderListRequest("LTCBTC").
+			ListClientOrderId("h2USkA5YQpaXHPIrkd96xE").
+			NewClientOrderId("cancel").
+			RecvWindow(2)
+		response, _ := sdk.CancelOrderList(request)
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.CancelOrderList(NewCancelOrderListRequest("LTCBTC").OrderListId(27))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.CancelOrderList(NewCancelOrderListRequest("LTCBTC").OrderListId(27))
+
+		assert.Error(t, err)
+	})
+}
@@ -28,7 +28,7 @@ func TestSdk_CancelOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validCancelledOrderJson(), nil)
 
@@ -57,7 +57,7 @@ func TestSdk_CancelOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validCancelledOrderJson(), nil)
 
@@ -87,7 +87,7 @@ func TestSdk_CancelOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -113,7 +113,7 @@ func TestSdk_CancelOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
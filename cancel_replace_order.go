@@ -0,0 +1,149 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Result of a cancel-replace. Each step has either a response or an error, unless it has not been attempted.
+type CancelReplaceOrder struct {
+	CancelResult     CancelReplaceResult
+	NewOrderResult   CancelReplaceResult
+	CancelResponse   *OrderReport
+	CancelError      *APIError
+	NewOrderResponse *FullOrder
+	NewOrderError    *APIError
+}
+
+type cancelReplaceOrderRequest struct {
+	order                   *newOrderRequest
+	cancelReplaceMode       *CancelReplaceMode
+	cancelOrderId           *int64
+	cancelOrigClientOrderId *string
+	cancelNewClientOrderId  *string
+}
+
+// Cancels an order and places the new order in a single request. Either the cancel order id or the cancel original
+// client order id must be set.
+func NewCancelReplaceOrderRequest(order *newOrderRequest, mode CancelReplaceMode) *cancelReplaceOrderRequest {
+	return &cancelReplaceOrderRequest{
+		order:             order,
+		cancelReplaceMode: &mode,
+	}
+}
+
+func (r *cancelReplaceOrderRequest) CancelOrderId(value int64) *cancelReplaceOrderRequest {
+	r.cancelOrderId = &value
+	return r
+}
+
+func (r *cancelReplaceOrderRequest) CancelOrigClientOrderId(value string) *cancelReplaceOrderRequest {
+	r.cancelOrigClientOrderId = &value
+	return r
+}
+
+func (r *cancelReplaceOrderRequest) CancelNewClientOrderId(value string) *cancelReplaceOrderRequest {
+	r.cancelNewClientOrderId = &value
+	return r
+}
+
+// Cancels an order and places a new one. With STOP_ON_FAILURE the new order is only placed when the cancel succeeds.
+//
+// When any of the steps fails the api error is returned together with the result, which tells the outcome of each
+// step. Use errors.Is with ErrCancelReplacePartiallyFailed to know that one of the steps succeeded.
+func (sdk Sdk) CancelReplaceOrder(request *cancelReplaceOrderRequest) (*CancelReplaceOrder, error) {
+	return sdk.CancelReplaceOrderCtx(context.Background(), request)
+}
+
+func (sdk Sdk) CancelReplaceOrderCtx(ctx context.Context, request *cancelReplaceOrderRequest) (*CancelReplaceOrder, error) {
+	if !request.cancelReplaceMode.IsValid() {
+		return nil, fmt.Errorf("invalid cancel replace mode %q", *request.cancelReplaceMode)
+	}
+	if err := request.order.validate(); err != nil {
+		return nil, err
+	}
+
+	req := sdk.buildNewOrderRequest("/api/v3/order/cancelReplace", request.order).
+		StringParam("cancelReplaceMode", (*string)(request.cancelReplaceMode)).
+		Int64Param("cancelOrderId", request.cancelOrderId).
+		StringParam("cancelOrigClientOrderId", request.cancelOrigClientOrderId).
+		StringParam("cancelNewClientOrderId", request.cancelNewClientOrderId)
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		apiError := &APIError{}
+		if errors.As(err, &apiError) && len(apiError.Data) > 0 {
+			if response, parseErr := parseCancelReplaceOrderResponse(apiError.Data); parseErr == nil {
+				return response, err
+			}
+		}
+		return nil, err
+	}
+
+	return parseCancelReplaceOrderResponse(responseContent)
+}
+
+// Reads the responses of both steps, which are an api error when the step has failed.
+func (o *CancelReplaceOrder) UnmarshalJSON(data []byte) error {
+	content := struct {
+		CancelResult     CancelReplaceResult `json:"cancelResult"`
+		NewOrderResult   CancelReplaceResult `json:"newOrderResult"`
+		CancelResponse   json.RawMessage     `json:"cancelResponse"`
+		NewOrderResponse json.RawMessage     `json:"newOrderResponse"`
+	}{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+
+	*o = CancelReplaceOrder{CancelResult: content.CancelResult, NewOrderResult: content.NewOrderResult}
+
+	cancelResponse := &OrderReport{}
+	ok, cancelError, err := parseCancelReplaceStep(content.CancelResponse, cancelResponse)
+	if err != nil {
+		return err
+	}
+	if ok {
+		o.CancelResponse = cancelResponse
+	}
+	o.CancelError = cancelError
+
+	newOrderResponse := &FullOrder{}
+	ok, newOrderError, err := parseCancelReplaceStep(content.NewOrderResponse, newOrderResponse)
+	if err != nil {
+		return err
+	}
+	if ok {
+		o.NewOrderResponse = newOrderResponse
+	}
+	o.NewOrderError = newOrderError
+	return nil
+}
+
+// Decodes the response of a step. It reports whether the response has been filled, which is false when the step
+// has failed or has not been attempted.
+func parseCancelReplaceStep(data json.RawMessage, response interface{}) (bool, *APIError, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return false, nil, nil
+	}
+
+	apiError := &APIError{}
+	if err := json.Unmarshal(data, apiError); err != nil {
+		return false, nil, err
+	}
+	if apiError.Code != 0 {
+		return false, apiError, nil
+	}
+
+	if err := json.Unmarshal(data, response); err != nil {
+		return false, nil, err
+	}
+	return true, nil, nil
+}
+
+func parseCancelReplaceOrderResponse(jsonContent []byte) (*CancelReplaceOrder, error) {
+	response := &CancelReplaceOrder{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
@@ -0,0 +1,243 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_CancelReplaceOrder(t *testing.T) {
+	method, url := "POST", "/api/v3/order/cancelReplace"
+
+	t.Run("It should convert api response to a CancelReplaceOrder", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "SELL").
+			Param("type", "LIMIT").
+			Param("quantity", "10").
+			Param("timeInForce", "GTC").
+			Param("price", "0.1").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Param("cancelReplaceMode", "STOP_ON_FAILURE").
+			Param("cancelOrderId", "27").
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validCancelReplaceOrderJson(), nil)
+
+		response, err := sdk.CancelReplaceOrder(validCancelReplaceOrderRequest())
+
+		assert.NoError(t, err)
+		assert.Equal(t, validCancelReplaceOrderResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "SELL").
+			Param("type", "MARKET").
+			Param("quantity", "10").
+			Param("newClientOrderId", "new").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Param("cancelReplaceMode", "ALLOW_FAILURE").
+			Param("cancelOrigClientOrderId", "original").
+			Param("cancelNewClientOrderId", "cancel").
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validCancelReplaceOrderJson(), nil)
+
+		order := NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10")).NewClientOrderId("new")
+		request := NewCancelReplaceOrderRequest(order, CancelReplaceModeAllowFailure).
+			CancelOrigClientOrderId("original").
+			CancelNewClientOrderId("cancel")
+		response, _ := sdk.CancelReplaceOrder(request)
+
+		assert.Equal(t, validCancelReplaceOrderResponse(), response)
+	})
+
+	t.Run("It should return the result of each step when one of them fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		apiError := newAPIError(409, []byte(`{
+			"code": -2022,
+			"msg": "Order cancel-replace partially failed.",
+			"data": {
+				"cancelResult": "SUCCESS",
+				"newOrderResult": "FAILURE",
+				"cancelResponse": `+string(validCancelReplaceCancelJson())+`,
+				"newOrderResponse": {
+					"code": -2010,
+					"msg": "Order would immediately match and take."
+				}
+			}
+		}`))
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, apiError)
+
+		response, err := sdk.CancelReplaceOrder(validCancelReplaceOrderRequest())
+
+		assert.True(t, errors.Is(err, ErrCancelReplacePartiallyFailed))
+		assert.Equal(t, &CancelReplaceOrder{
+			CancelResult:   CancelReplaceResultSuccess,
+			NewOrderResult: CancelReplaceResultFailure,
+			CancelResponse: validCancelReplaceCancelResponse(),
+			NewOrderError:  &APIError{Code: -2010, Message: "Order would immediately match and take."},
+		}, response)
+	})
+
+	t.Run("It should return error without calling the api when the mode is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		order := NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10"))
+		_, err := sdk.CancelReplaceOrder(NewCancelReplaceOrderRequest(order, "STOP"))
+
+		assert.EqualError(t, err, `invalid cancel replace mode "STOP"`)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		response, err := sdk.CancelReplaceOrder(validCancelReplaceOrderRequest())
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.CancelReplaceOrder(validCancelReplaceOrderRequest())
+
+		assert.Error(t, err)
+	})
+}
+
+func validCancelReplaceOrderRequest() *cancelReplaceOrderRequest {
+	order := NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("10")).
+		Price(MustDecimal("0.1")).
+		TimeInForce(TimeInForceGTC)
+	return NewCancelReplaceOrderRequest(order, CancelReplaceModeStopOnFailure).CancelOrderId(27)
+}
+
+func validCancelReplaceOrderJson() []byte {
+	return []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": ` + string(validCancelReplaceCancelJson()) + `,
+		"newOrderResponse": ` + string(validFullOrderJson()) + `
+	}`)
+}
+
+func validCancelReplaceOrderResponse() *CancelReplaceOrder {
+	return &CancelReplaceOrder{
+		CancelResult:     CancelReplaceResultSuccess,
+		NewOrderResult:   CancelReplaceResultSuccess,
+		CancelResponse:   validCancelReplaceCancelResponse(),
+		NewOrderResponse: validFullOrderResponse(),
+	}
+}
+
+func validCancelReplaceCancelJson() []byte {
+	return []byte(`{
+		"symbol": "BTCUSDT",
+		"origClientOrderId": "original",
+		"orderId": 27,
+		"orderListId": -1,
+		"clientOrderId": "cancel",
+		"transactTime": 1669277163808,
+		"price": "0.2",
+		"origQty": "10.00000000",
+		"executedQty": "0.00000000",
+		"cummulativeQuoteQty": "0.00000000",
+		"status": "CANCELED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "SELL",
+		"selfTradePreventionMode": "NONE"
+	}`)
+}
+
+func validCancelReplaceCancelResponse() *OrderReport {
+	return &OrderReport{
+		FullOrder: FullOrder{OrderResult: OrderResult{
+			OrderAck: OrderAck{
+				Symbol:          "BTCUSDT",
+				OrderId:         27,
+				OrderListId:     -1,
+				ClientOrderId:   "cancel",
+				TransactionTime: 1669277163808,
+			},
+			Price:                   MustDecimal("0.2"),
+			OriginalQuantity:        MustDecimal("10"),
+			Status:                  OrderStatusCanceled,
+			TimeInForce:             TimeInForceGTC,
+			Type:                    OrderTypeLimit,
+			Side:                    SideSell,
+			SelfTradePreventionMode: "NONE",
+		}},
+		OrigClientOrderId: "original",
+	}
+}
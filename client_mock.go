@@ -5,6 +5,7 @@
 package binance
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -68,14 +69,14 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // Do mocks base method
-func (m *MockClient) Do(request *request) ([]byte, error) {
-	ret := m.ctrl.Call(m, "Do", request)
+func (m *MockClient) Do(ctx context.Context, request *request) ([]byte, error) {
+	ret := m.ctrl.Call(m, "Do", ctx, request)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do
-func (mr *MockClientMockRecorder) Do(request interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockClient)(nil).Do), request)
+func (mr *MockClientMockRecorder) Do(ctx, request interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockClient)(nil).Do), ctx, request)
 }
@@ -0,0 +1,262 @@
+package binance
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Exact decimal number used for prices and quantities.
+//
+// Binance sends prices and quantities as strings to avoid the rounding errors of float64, so they are kept as an
+// arbitrary precision integer and the number of decimals. Decimals are immutable, every operation returns a new one.
+// The zero value is 0.
+type Decimal struct {
+	value *big.Int
+	scale int32
+}
+
+var ten = big.NewInt(10)
+
+// Largest number of decimals, or of zeros of an integer, accepted when parsing. It rejects values like 1e2000000000
+// that would need billions of digits.
+const maxDecimalScale = 1000
+
+// Returns the decimal value * 10^-scale. For example NewDecimal(15, 2) is 0.15.
+func NewDecimal(value int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(value), scale)
+}
+
+// Parses a decimal like "0.00100000", "-12.5" or "1e-8".
+func NewDecimalFromString(value string) (Decimal, error) {
+	number, exponent := value, int64(0)
+	if i := strings.IndexAny(number, "eE"); i >= 0 {
+		parsed, err := strconv.ParseInt(number[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, errors.New("invalid decimal: " + value)
+		}
+		number, exponent = number[:i], parsed
+	}
+
+	integer, fraction := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+
+	digits := strings.TrimLeft(integer, "+-")
+	if len(integer)-len(digits) > 1 || digits+fraction == "" || strings.ContainsAny(digits+fraction, "+-") {
+		return Decimal{}, errors.New("invalid decimal: " + value)
+	}
+
+	scale := int64(len(fraction)) - exponent
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, errors.New("decimal out of range: " + value)
+	}
+
+	unscaled, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok {
+		return Decimal{}, errors.New("invalid decimal: " + value)
+	}
+	return newDecimal(unscaled, int32(scale)), nil
+}
+
+// Same as NewDecimalFromString but it panics when the value is not a valid decimal. Useful for constants.
+func MustDecimal(value string) Decimal {
+	decimal, err := NewDecimalFromString(value)
+	if err != nil {
+		panic(err)
+	}
+	return decimal
+}
+
+// Converts a float64 to the shortest decimal that represents it. It helps to migrate code that used float64 values.
+func NewDecimalFromFloat(value float64) Decimal {
+	decimal, _ := NewDecimalFromString(strconv.FormatFloat(value, 'g', -1, 64))
+	return decimal
+}
+
+// Removes the trailing zeros, so equal numbers have the same representation.
+func newDecimal(value *big.Int, scale int32) Decimal {
+	if value.Sign() == 0 {
+		return Decimal{}
+	}
+
+	if scale < 0 {
+		return Decimal{value: new(big.Int).Mul(value, pow10(-scale))}
+	}
+
+	value = new(big.Int).Set(value)
+	quotient, remainder := new(big.Int), new(big.Int)
+	for scale > 0 {
+		quotient.QuoRem(value, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		value, quotient = quotient, value
+		scale--
+	}
+	return Decimal{value: value, scale: scale}
+}
+
+func pow10(exponent int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(exponent)), nil)
+}
+
+func (d Decimal) unscaled() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// Returns the unscaled value for the given scale, which cannot be less than the scale of the decimal.
+func (d Decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.unscaled(), pow10(scale-d.scale))
+}
+
+func maxScale(a Decimal, b Decimal) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := maxScale(d, other)
+	return newDecimal(new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale)
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	scale := maxScale(d, other)
+	return newDecimal(new(big.Int).Sub(d.rescale(scale), other.rescale(scale)), scale)
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return newDecimal(new(big.Int).Mul(d.unscaled(), other.unscaled()), d.scale+other.scale)
+}
+
+// Divides by other truncating the result to the given number of decimals. It panics when other is zero.
+func (d Decimal) Div(other Decimal, decimals int32) Decimal {
+	numerator, denominator := d.unscaled(), other.unscaled()
+	if exponent := other.scale + decimals - d.scale; exponent >= 0 {
+		numerator = new(big.Int).Mul(numerator, pow10(exponent))
+	} else {
+		denominator = new(big.Int).Mul(denominator, pow10(-exponent))
+	}
+	return newDecimal(new(big.Int).Quo(numerator, denominator), decimals)
+}
+
+func (d Decimal) Neg() Decimal {
+	return newDecimal(new(big.Int).Neg(d.unscaled()), d.scale)
+}
+
+func (d Decimal) Abs() Decimal {
+	return newDecimal(new(big.Int).Abs(d.unscaled()), d.scale)
+}
+
+// Returns -1, 0 or +1 when the decimal is less than, equal to or greater than other.
+func (d Decimal) Cmp(other Decimal) int {
+	scale := maxScale(d, other)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+// Returns -1, 0 or +1 when the decimal is negative, zero or positive.
+func (d Decimal) Sign() int {
+	return d.unscaled().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Number of decimals needed to represent the value.
+func (d Decimal) Decimals() int32 {
+	return d.scale
+}
+
+// Removes the decimals beyond the given number of decimals.
+func (d Decimal) Truncate(decimals int32) Decimal {
+	if d.scale <= decimals {
+		return d
+	}
+	return newDecimal(new(big.Int).Quo(d.unscaled(), pow10(d.scale-decimals)), decimals)
+}
+
+// Rounds down to a multiple of step, like a quantity to the step size of a symbol. A step that is not positive
+// leaves the decimal unchanged.
+func (d Decimal) FloorToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+
+	scale := maxScale(d, step)
+	unscaledStep := step.rescale(scale)
+	steps := new(big.Int).Div(d.rescale(scale), unscaledStep)
+	return newDecimal(steps.Mul(steps, unscaledStep), scale)
+}
+
+// Rounds half up to the nearest multiple of step, like a price to the tick size of a symbol. A step that is not
+// positive leaves the decimal unchanged.
+func (d Decimal) RoundToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	return d.Add(step.Div(NewDecimal(2, 0), step.scale+1)).FloorToStep(step)
+}
+
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled()).String()
+	if d.scale > 0 {
+		if padding := int(d.scale) + 1 - len(digits); padding > 0 {
+			digits = strings.Repeat("0", padding) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	}
+
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Nearest float64 to the decimal.
+func (d Decimal) Float64() float64 {
+	value, _ := strconv.ParseFloat(d.String(), 64)
+	return value
+}
+
+// Encodes the decimal as a json string, like the api does.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// Decodes json strings and numbers. Empty strings and null are decoded as zero.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	decimal, err := NewDecimalFromString(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = decimal
+	return nil
+}
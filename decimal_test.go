@@ -0,0 +1,125 @@
+package binance
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewDecimalFromString(t *testing.T) {
+	t.Run("It should parse the decimals sent by the api", func(t *testing.T) {
+		for value, expected := range map[string]string{
+			"0.00100000":        "0.001",
+			"10.00000000":       "10",
+			"-12.50":            "-12.5",
+			"+3":                "3",
+			".5":                "0.5",
+			"1e-8":              "0.00000001",
+			"1.5E3":             "1500",
+			"0.00000000":        "0",
+			"17928899.62484339": "17928899.62484339",
+			"123456789012345678901234567890.123456789": "123456789012345678901234567890.123456789",
+		} {
+			decimal, err := NewDecimalFromString(value)
+
+			assert.NoError(t, err, value)
+			assert.Equal(t, expected, decimal.String(), value)
+		}
+	})
+
+	t.Run("It should return error for invalid decimals", func(t *testing.T) {
+		for _, value := range []string{"", ".", "-", "1.2.3", "1.-2", "+-1", "abc", "1e", "0x10", "1_000"} {
+			_, err := NewDecimalFromString(value)
+
+			assert.Error(t, err, value)
+		}
+	})
+
+	t.Run("It should return error for exponents out of range", func(t *testing.T) {
+		for _, value := range []string{"1e2000000000", "1e-2000000000", "1e1001", "0." + strings.Repeat("0", 1000) + "1"} {
+			_, err := NewDecimalFromString(value)
+
+			assert.Error(t, err, value)
+		}
+
+		_, err := NewDecimalFromString("1e1000")
+		assert.NoError(t, err)
+	})
+
+	t.Run("It should represent equal numbers in the same way", func(t *testing.T) {
+		assert.Equal(t, MustDecimal("0.1"), MustDecimal("0.10000000"))
+		assert.Equal(t, Decimal{}, MustDecimal("0.000"))
+		assert.Equal(t, NewDecimal(15, 2), MustDecimal("0.15"))
+		assert.Equal(t, NewDecimal(100, 0), MustDecimal("1e2"))
+	})
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	assert.Equal(t, MustDecimal("0.1"), NewDecimalFromFloat(0.1))
+	assert.Equal(t, MustDecimal("0.00000001"), NewDecimalFromFloat(0.00000001))
+	assert.Equal(t, MustDecimal("4000"), NewDecimalFromFloat(4000))
+	assert.Equal(t, 0.079466, MustDecimal("0.07946600").Float64())
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a, b := MustDecimal("0.1"), MustDecimal("0.2")
+
+	assert.Equal(t, MustDecimal("0.3"), a.Add(b))
+	assert.Equal(t, MustDecimal("-0.1"), a.Sub(b))
+	assert.Equal(t, MustDecimal("0.02"), a.Mul(b))
+	assert.Equal(t, MustDecimal("0.5"), a.Div(b, 8))
+	assert.Equal(t, MustDecimal("0.33333333"), MustDecimal("1").Div(MustDecimal("3"), 8))
+	assert.Equal(t, MustDecimal("-0.3333"), MustDecimal("-1").Div(MustDecimal("3"), 4))
+	assert.Equal(t, MustDecimal("0.1"), a.Neg().Abs())
+	assert.Equal(t, MustDecimal("1.23"), MustDecimal("1.23456").Truncate(2))
+	assert.Equal(t, MustDecimal("1.2"), MustDecimal("1.2").Truncate(2))
+	assert.Equal(t, Decimal{}, Decimal{}.Truncate(-1))
+}
+
+func TestDecimal_Comparison(t *testing.T) {
+	a, b := MustDecimal("0.1"), MustDecimal("0.10001")
+
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.True(t, a.LessThan(b))
+	assert.True(t, b.GreaterThan(a))
+	assert.True(t, a.Equal(NewDecimal(1, 1)))
+	assert.True(t, Decimal{}.IsZero())
+	assert.Equal(t, -1, a.Neg().Sign())
+	assert.Equal(t, int32(5), b.Decimals())
+}
+
+func TestDecimal_Steps(t *testing.T) {
+	step := MustDecimal("0.001")
+
+	assert.Equal(t, MustDecimal("1.234"), MustDecimal("1.23499").FloorToStep(step))
+	assert.Equal(t, MustDecimal("1.235"), MustDecimal("1.2345").RoundToStep(step))
+	assert.Equal(t, MustDecimal("1.234"), MustDecimal("1.23449").RoundToStep(step))
+	assert.Equal(t, MustDecimal("25"), MustDecimal("37.5").FloorToStep(MustDecimal("25")))
+	assert.Equal(t, MustDecimal("1.23499"), MustDecimal("1.23499").FloorToStep(Decimal{}))
+	assert.Equal(t, MustDecimal("1.23499"), MustDecimal("1.23499").RoundToStep(Decimal{}))
+	assert.Equal(t, MustDecimal("1.23499"), MustDecimal("1.23499").RoundToStep(MustDecimal("-0.001")))
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	t.Run("It should decode json strings and numbers", func(t *testing.T) {
+		var values []Decimal
+		err := json.Unmarshal([]byte(`["0.00100000", 12.5, "", null]`), &values)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Decimal{MustDecimal("0.001"), MustDecimal("12.5"), {}, {}}, values)
+	})
+
+	t.Run("It should encode the decimal as a json string", func(t *testing.T) {
+		content, err := json.Marshal(MustDecimal("0.00100000"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, `"0.001"`, string(content))
+	})
+
+	t.Run("It should return error for invalid decimals", func(t *testing.T) {
+		var value Decimal
+		assert.Error(t, json.Unmarshal([]byte(`"abc"`), &value))
+		assert.Error(t, json.Unmarshal([]byte(`1e2000000000`), &value))
+	})
+}
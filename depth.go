@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
@@ -17,8 +18,8 @@ type Depth struct {
 }
 
 type DepthOrder struct {
-	Price    float64
-	Quantity float64
+	Price    Decimal
+	Quantity Decimal
 }
 
 func parseDepthResponse(jsonContent []byte) (*depthResponse, error) {
@@ -36,8 +37,8 @@ type depthQuery struct {
 func convertDepthOrders(sliceOfDepthOrders [][]interface{}) []DepthOrder {
 	depthOrders := make([]DepthOrder, 0)
 	for _, bid := range sliceOfDepthOrders {
-		price, _ := strconv.ParseFloat(bid[0].(string), 64)
-		quantity, _ := strconv.ParseFloat(bid[1].(string), 64)
+		price, _ := NewDecimalFromString(bid[0].(string))
+		quantity, _ := NewDecimalFromString(bid[1].(string))
 
 		depthOrders = append(depthOrders, DepthOrder{Price: price, Quantity: quantity})
 	}
@@ -67,11 +68,16 @@ func (d *depthQuery) Limit(limit int) *depthQuery {
 //
 // Caution: setting limit=0 can return a lot of data.
 func (sdk Sdk) Depth(query *depthQuery) (*Depth, error) {
+	return sdk.DepthCtx(context.Background(), query)
+}
+
+// Same as Depth but the request is bound to the given context.
+func (sdk Sdk) DepthCtx(ctx context.Context, query *depthQuery) (*Depth, error) {
 	request := newRequest("GET", "/api/v1/depth").
 		Param("symbol", query.symbol).
 		Param("limit", strconv.Itoa(query.limit))
 
-	responseContent, err := sdk.client.Do(request)
+	responseContent, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +20,7 @@ func TestSdk_Depth(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validDepthJson(), nil)
 
@@ -39,7 +39,7 @@ func TestSdk_Depth(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validDepthJson(), nil)
 
@@ -59,7 +59,7 @@ func TestSdk_Depth(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -78,7 +78,7 @@ func TestSdk_Depth(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -112,10 +112,10 @@ func validDepthResponse() *Depth {
 	return &Depth{
 		LastUpdateId: 1027024,
 		Bids: []DepthOrder{
-			{Price: 4.0, Quantity: 431.0},
+			{Price: MustDecimal("4"), Quantity: MustDecimal("431")},
 		},
 		Asks: []DepthOrder{
-			{Price: 4.000002, Quantity: 12.05},
+			{Price: MustDecimal("4.000002"), Quantity: MustDecimal("12.05")},
 		},
 	}
 }
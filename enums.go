@@ -0,0 +1,181 @@
+package binance
+
+const (
+	SideBuy  OrderSide = "BUY"
+	SideSell OrderSide = "SELL"
+)
+
+const (
+	OrderTypeLimit           OrderType = "LIMIT"
+	OrderTypeMarket          OrderType = "MARKET"
+	OrderTypeStopLoss        OrderType = "STOP_LOSS"
+	OrderTypeStopLossLimit   OrderType = "STOP_LOSS_LIMIT"
+	OrderTypeTakeProfit      OrderType = "TAKE_PROFIT"
+	OrderTypeTakeProfitLimit OrderType = "TAKE_PROFIT_LIMIT"
+	OrderTypeLimitMaker      OrderType = "LIMIT_MAKER"
+)
+
+const (
+	TimeInForceGTC TimeInForce = "GTC"
+	TimeInForceIOC TimeInForce = "IOC"
+	TimeInForceFOK TimeInForce = "FOK"
+)
+
+const (
+	OrderStatusNew             OrderStatus = "NEW"
+	OrderStatusPendingNew      OrderStatus = "PENDING_NEW"
+	OrderStatusPartiallyFilled OrderStatus = "PARTIALLY_FILLED"
+	OrderStatusFilled          OrderStatus = "FILLED"
+	OrderStatusCanceled        OrderStatus = "CANCELED"
+	OrderStatusPendingCancel   OrderStatus = "PENDING_CANCEL"
+	OrderStatusRejected        OrderStatus = "REJECTED"
+	OrderStatusExpired         OrderStatus = "EXPIRED"
+	OrderStatusExpiredInMatch  OrderStatus = "EXPIRED_IN_MATCH"
+)
+
+const (
+	NewOrderRespTypeAck    NewOrderResponseType = "ACK"
+	NewOrderRespTypeResult NewOrderResponseType = "RESULT"
+	NewOrderRespTypeFull   NewOrderResponseType = "FULL"
+)
+
+const (
+	SelfTradePreventionModeNone        SelfTradePreventionMode = "NONE"
+	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
+	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
+	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
+	SelfTradePreventionModeDecrement   SelfTradePreventionMode = "DECREMENT"
+	SelfTradePreventionModeTransfer    SelfTradePreventionMode = "TRANSFER"
+)
+
+const (
+	PegPriceTypePrimaryPeg PegPriceType = "PRIMARY_PEG"
+	PegPriceTypeMarketPeg  PegPriceType = "MARKET_PEG"
+)
+
+const (
+	ContingencyTypeOCO ContingencyType = "OCO"
+	ContingencyTypeOTO ContingencyType = "OTO"
+)
+
+const (
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+)
+
+const (
+	ListOrderStatusExecuting ListOrderStatus = "EXECUTING"
+	ListOrderStatusAllDone   ListOrderStatus = "ALL_DONE"
+	ListOrderStatusReject    ListOrderStatus = "REJECT"
+)
+
+const (
+	CancelReplaceModeStopOnFailure CancelReplaceMode = "STOP_ON_FAILURE"
+	CancelReplaceModeAllowFailure  CancelReplaceMode = "ALLOW_FAILURE"
+)
+
+const (
+	CancelReplaceResultSuccess      CancelReplaceResult = "SUCCESS"
+	CancelReplaceResultFailure      CancelReplaceResult = "FAILURE"
+	CancelReplaceResultNotAttempted CancelReplaceResult = "NOT_ATTEMPTED"
+)
+
+type OrderSide string
+
+type OrderType string
+
+type TimeInForce string
+
+type OrderStatus string
+
+type NewOrderResponseType string
+
+// What happens when an order would match another order of the same account.
+type SelfTradePreventionMode string
+
+// Price that a pegged order follows: the best price of its side (PRIMARY_PEG) or of the opposite side (MARKET_PEG).
+type PegPriceType string
+
+// Kind of order list.
+type ContingencyType string
+
+// Status of an order list as a whole.
+type ListStatusType string
+
+// Status of the orders of an order list.
+type ListOrderStatus string
+
+// Whether a cancel-replace places the new order when the cancel fails.
+type CancelReplaceMode string
+
+// Outcome of each step of a cancel-replace.
+type CancelReplaceResult string
+
+func (s OrderSide) IsValid() bool {
+	return s == SideBuy || s == SideSell
+}
+
+func (t OrderType) IsValid() bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeMarket, OrderTypeStopLoss, OrderTypeStopLossLimit, OrderTypeTakeProfit,
+		OrderTypeTakeProfitLimit, OrderTypeLimitMaker:
+		return true
+	}
+	return false
+}
+
+// Reports whether orders of the type have a price, which is also the type of orders that can be pegged or have an
+// iceberg quantity.
+func (t OrderType) hasPrice() bool {
+	return t == OrderTypeLimit || t == OrderTypeLimitMaker || t == OrderTypeStopLossLimit || t == OrderTypeTakeProfitLimit
+}
+
+// Reports whether orders of the type require a time in force.
+func (t OrderType) hasTimeInForce() bool {
+	return t == OrderTypeLimit || t == OrderTypeStopLossLimit || t == OrderTypeTakeProfitLimit
+}
+
+// Reports whether orders of the type are triggered by a stop price or a trailing delta.
+func (t OrderType) isStop() bool {
+	return t == OrderTypeStopLoss || t == OrderTypeStopLossLimit || t == OrderTypeTakeProfit || t == OrderTypeTakeProfitLimit
+}
+
+func (t TimeInForce) IsValid() bool {
+	return t == TimeInForceGTC || t == TimeInForceIOC || t == TimeInForceFOK
+}
+
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusNew, OrderStatusPendingNew, OrderStatusPartiallyFilled, OrderStatusFilled, OrderStatusCanceled,
+		OrderStatusPendingCancel, OrderStatusRejected, OrderStatusExpired, OrderStatusExpiredInMatch:
+		return true
+	}
+	return false
+}
+
+// Reports whether the order can still be filled.
+func (s OrderStatus) IsOpen() bool {
+	return s == OrderStatusNew || s == OrderStatusPendingNew || s == OrderStatusPartiallyFilled
+}
+
+func (t NewOrderResponseType) IsValid() bool {
+	return t == NewOrderRespTypeAck || t == NewOrderRespTypeResult || t == NewOrderRespTypeFull
+}
+
+func (m CancelReplaceMode) IsValid() bool {
+	return m == CancelReplaceModeStopOnFailure || m == CancelReplaceModeAllowFailure
+}
+
+func (m SelfTradePreventionMode) IsValid() bool {
+	switch m {
+	case SelfTradePreventionModeNone, SelfTradePreventionModeExpireTaker, SelfTradePreventionModeExpireMaker,
+		SelfTradePreventionModeExpireBoth, SelfTradePreventionModeDecrement, SelfTradePreventionModeTransfer:
+		return true
+	}
+	return false
+}
+
+func (t PegPriceType) IsValid() bool {
+	return t == PegPriceTypePrimaryPeg || t == PegPriceTypeMarketPeg
+}
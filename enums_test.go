@@ -0,0 +1,52 @@
+package binance
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnums_IsValid(t *testing.T) {
+	assert.True(t, SideSell.IsValid())
+	assert.False(t, OrderSide("SEL").IsValid())
+
+	assert.True(t, OrderTypeLimitMaker.IsValid())
+	assert.False(t, OrderType("STOP").IsValid())
+
+	assert.True(t, TimeInForceFOK.IsValid())
+	assert.False(t, TimeInForce("GTD").IsValid())
+
+	assert.True(t, OrderStatusPartiallyFilled.IsValid())
+	assert.False(t, OrderStatus("PARTIAL").IsValid())
+
+	assert.True(t, NewOrderRespTypeAck.IsValid())
+	assert.False(t, NewOrderResponseType("MINI").IsValid())
+
+	assert.True(t, SelfTradePreventionModeExpireBoth.IsValid())
+	assert.False(t, SelfTradePreventionMode("EXPIRE").IsValid())
+
+	assert.True(t, PegPriceTypeMarketPeg.IsValid())
+	assert.False(t, PegPriceType("PEG").IsValid())
+
+	assert.True(t, CancelReplaceModeAllowFailure.IsValid())
+	assert.False(t, CancelReplaceMode("ALLOW").IsValid())
+}
+
+func TestOrderStatus_IsOpen(t *testing.T) {
+	assert.True(t, OrderStatusPartiallyFilled.IsOpen())
+	assert.False(t, OrderStatusCanceled.IsOpen())
+}
+
+func TestEnums_JSON(t *testing.T) {
+	order := &Order{}
+	err := json.Unmarshal([]byte(`{"status":"PARTIALLY_FILLED","timeInForce":"FOK","type":"LIMIT_MAKER","side":"BUY"}`), order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, OrderStatusPartiallyFilled, order.Status)
+	assert.Equal(t, TimeInForceFOK, order.TimeInForce)
+	assert.Equal(t, OrderTypeLimitMaker, order.Type)
+	assert.Equal(t, SideBuy, order.Side)
+
+	content, _ := json.Marshal(SideSell)
+	assert.Equal(t, `"SELL"`, string(content))
+}
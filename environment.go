@@ -0,0 +1,74 @@
+package binance
+
+// Binance deployment the sdk connects to.
+//
+// RestURLs are the base urls of the Rest API. The first one is used by default and the rest are used in order when
+// it is unreachable. WebSocketURL is the base url of the web socket streams.
+type Environment struct {
+	Name         string
+	RestURLs     []string
+	WebSocketURL string
+}
+
+var (
+	// Binance production. Falls back to the alternative api1, api2 and api3 clusters.
+	EnvironmentProduction = Environment{
+		Name:         "production",
+		RestURLs:     []string{"https://api.binance.com", "https://api1.binance.com", "https://api2.binance.com", "https://api3.binance.com"},
+		WebSocketURL: "wss://stream.binance.com:9443",
+	}
+
+	// Binance production using the api1 cluster first.
+	EnvironmentAPI1 = Environment{
+		Name:         "api1",
+		RestURLs:     []string{"https://api1.binance.com", "https://api2.binance.com", "https://api3.binance.com", "https://api.binance.com"},
+		WebSocketURL: "wss://stream.binance.com:9443",
+	}
+
+	// Binance production using the api2 cluster first.
+	EnvironmentAPI2 = Environment{
+		Name:         "api2",
+		RestURLs:     []string{"https://api2.binance.com", "https://api3.binance.com", "https://api.binance.com", "https://api1.binance.com"},
+		WebSocketURL: "wss://stream.binance.com:9443",
+	}
+
+	// Binance production using the api3 cluster first.
+	EnvironmentAPI3 = Environment{
+		Name:         "api3",
+		RestURLs:     []string{"https://api3.binance.com", "https://api.binance.com", "https://api1.binance.com", "https://api2.binance.com"},
+		WebSocketURL: "wss://stream.binance.com:9443",
+	}
+
+	// Spot test network. It needs its own api keys.
+	EnvironmentTestnet = Environment{
+		Name:         "testnet",
+		RestURLs:     []string{"https://testnet.binance.vision"},
+		WebSocketURL: "wss://stream.testnet.binance.vision",
+	}
+
+	// Binance.US. It needs its own api keys.
+	EnvironmentBinanceUS = Environment{
+		Name:         "binance.us",
+		RestURLs:     []string{"https://api.binance.us"},
+		WebSocketURL: "wss://stream.binance.us:9443",
+	}
+)
+
+var environments = []Environment{
+	EnvironmentProduction,
+	EnvironmentAPI1,
+	EnvironmentAPI2,
+	EnvironmentAPI3,
+	EnvironmentTestnet,
+	EnvironmentBinanceUS,
+}
+
+// Finds a predefined environment by its name, so it can be chosen from the configuration of your application.
+func LookupEnvironment(name string) (Environment, bool) {
+	for _, environment := range environments {
+		if environment.Name == name {
+			return environment, true
+		}
+	}
+	return Environment{}, false
+}
@@ -0,0 +1,28 @@
+package binance
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLookupEnvironment(t *testing.T) {
+	t.Run("It should find the predefined environments by name", func(t *testing.T) {
+		environment, ok := LookupEnvironment("testnet")
+
+		assert.True(t, ok)
+		assert.Equal(t, EnvironmentTestnet, environment)
+	})
+
+	t.Run("It should not find unknown environments", func(t *testing.T) {
+		_, ok := LookupEnvironment("mainnet")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestWithEnvironment(t *testing.T) {
+	sdk := New("api-key", "api-secret", WithEnvironment(EnvironmentBinanceUS))
+
+	assert.Equal(t, EnvironmentBinanceUS, sdk.Environment())
+	assert.Equal(t, []string{"https://api.binance.us"}, sdk.client.(*client).baseUrls)
+}
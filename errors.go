@@ -0,0 +1,99 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Error returned when the api answers with a non successful status code.
+//
+// Code and Message are read from the json body sent by Binance. Use errors.Is with
+// the predefined errors to check for a concrete error code.
+//
+// RetryAfter is the time to wait sent with 429 and 418 responses. When the IP has been banned
+// (status 418), BannedUntil is the time when the ban ends.
+//
+// Data is the payload sent with some errors, like the result of each step of a failed cancel-replace.
+type APIError struct {
+	StatusCode  int
+	Code        int             `json:"code"`
+	Message     string          `json:"msg"`
+	Data        json.RawMessage `json:"data"`
+	RetryAfter  time.Duration
+	BannedUntil time.Time
+}
+
+var (
+	// Too many requests queued (-1003).
+	ErrTooManyRequests = &APIError{Code: -1003}
+
+	// Timestamp for this request is outside of the recvWindow (-1021).
+	ErrTimestampOutsideRecvWindow = &APIError{Code: -1021}
+
+	// The order does not pass the filters of the symbol (-1013).
+	ErrFilterFailure = &APIError{Code: -1013}
+
+	// The new order was rejected, usually because the account has insufficient balance (-2010).
+	ErrInsufficientBalance = &APIError{Code: -2010}
+
+	// The order to cancel or query does not exist (-2011).
+	ErrUnknownOrder = &APIError{Code: -2011}
+
+	// Neither the cancel nor the new order of a cancel-replace succeeded (-2021).
+	ErrCancelReplaceFailed = &APIError{Code: -2021}
+
+	// Either the cancel or the new order of a cancel-replace failed (-2022).
+	ErrCancelReplacePartiallyFailed = &APIError{Code: -2022}
+)
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiError := &APIError{}
+	if err := json.Unmarshal(body, apiError); err != nil || apiError.Code == 0 && apiError.Message == "" {
+		apiError.Message = string(body)
+	}
+	apiError.StatusCode = statusCode
+
+	if statusCode == 418 {
+		apiError.BannedUntil = parseBannedUntil(apiError.Message)
+	}
+
+	return apiError
+}
+
+var bannedUntilPattern = regexp.MustCompile(`banned until (\d+)`)
+
+// Reads the end of the ban from messages like "Way too much request weight used; IP banned until 1659146400000.".
+func parseBannedUntil(message string) time.Time {
+	match := bannedUntilPattern.FindStringSubmatch(message)
+	if match == nil {
+		return time.Time{}
+	}
+
+	milliseconds, _ := strconv.ParseInt(match[1], 10, 64)
+	return time.Unix(0, milliseconds*int64(time.Millisecond))
+}
+
+// Reads the Retry-After header, which is sent in seconds.
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (e *APIError) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("Error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("Error %d: %s (code %d)", e.StatusCode, e.Message, e.Code)
+}
+
+// Reports whether the target is an *APIError with the same Binance error code.
+func (e *APIError) Is(target error) bool {
+	apiError, ok := target.(*APIError)
+	return ok && apiError.Code == e.Code
+}
@@ -0,0 +1,35 @@
+package binance
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Run("It should read the code and message from the response body", func(t *testing.T) {
+		err := newAPIError(400, []byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`))
+
+		assert.Equal(t, &APIError{
+			StatusCode: 400,
+			Code:       -1021,
+			Message:    "Timestamp for this request is outside of the recvWindow.",
+		}, err)
+		assert.Equal(t, "Error 400: Timestamp for this request is outside of the recvWindow. (code -1021)", err.Error())
+	})
+
+	t.Run("It should keep the raw body when it is not a json error", func(t *testing.T) {
+		err := newAPIError(502, invalidJson())
+
+		assert.Equal(t, &APIError{StatusCode: 502, Message: string(invalidJson())}, err)
+		assert.Equal(t, "Error 502: "+string(invalidJson()), err.Error())
+	})
+}
+
+func TestAPIError_Is(t *testing.T) {
+	err := error(newAPIError(400, []byte(`{"code":-2011,"msg":"Unknown order sent."}`)))
+
+	assert.True(t, errors.Is(err, ErrUnknownOrder))
+	assert.False(t, errors.Is(err, ErrInsufficientBalance))
+	assert.False(t, errors.Is(err, errors.New("Unknown order sent.")))
+}
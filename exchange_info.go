@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 )
@@ -9,37 +10,68 @@ type ExchangeInfo struct {
 	Timezone        string
 	ServerTime      time.Duration
 	RateLimits      []RateLimits
-	ExchangeFilters []string
+	ExchangeFilters Filters
 	Symbols         []Symbol
 }
 
 type RateLimits struct {
 	RateLimitType string
 	Interval      string
+	IntervalNum   int
 	Limit         int
 }
 
 type Symbol struct {
-	Symbol             string
-	Status             string
-	BaseAsset          string
-	BaseAssetPrecision int
-	QuoteAsset         string
-	QuotePrecision     int
-	OrderTypes         []string
-	IcebergAllowed     bool
-	Filters            []Filter
+	Symbol                          string
+	Status                          string
+	BaseAsset                       string
+	BaseAssetPrecision              int
+	QuoteAsset                      string
+	QuotePrecision                  int
+	QuoteAssetPrecision             int
+	BaseCommissionPrecision         int
+	QuoteCommissionPrecision        int
+	OrderTypes                      []OrderType
+	IcebergAllowed                  bool
+	OcoAllowed                      bool
+	OtoAllowed                      bool
+	QuoteOrderQtyMarketAllowed      bool
+	AllowTrailingStop               bool
+	CancelReplaceAllowed            bool
+	IsSpotTradingAllowed            bool
+	IsMarginTradingAllowed          bool
+	Filters                         Filters
+	Permissions                     []string
+	PermissionSets                  [][]string
+	DefaultSelfTradePreventionMode  SelfTradePreventionMode
+	AllowedSelfTradePreventionModes []SelfTradePreventionMode
 }
 
-type Filter struct {
-	FilterType  string
-	MinPrice    float64 `json:",omitempty,string"`
-	MaxPrice    float64 `json:",omitempty,string"`
-	TickSize    float64 `json:",omitempty,string"`
-	MinQuantity float64 `json:"minQty,omitempty,string"`
-	MaxQuantity float64 `json:"maxQty,omitempty,string"`
-	StepSize    float64 `json:",omitempty,string"`
-	MinNotional float64 `json:",omitempty,string"`
+type exchangeInfoQuery struct {
+	symbol      *string
+	symbols     []string
+	permissions []string
+}
+
+// Query to get the exchange information of some symbols only.
+func NewExchangeInfoQuery() *exchangeInfoQuery {
+	return &exchangeInfoQuery{}
+}
+
+func (q *exchangeInfoQuery) Symbol(value string) *exchangeInfoQuery {
+	q.symbol = &value
+	return q
+}
+
+func (q *exchangeInfoQuery) Symbols(values ...string) *exchangeInfoQuery {
+	q.symbols = values
+	return q
+}
+
+// Symbols with any of the given permissions, like SPOT or MARGIN. It cannot be combined with Symbol or Symbols.
+func (q *exchangeInfoQuery) Permissions(values ...string) *exchangeInfoQuery {
+	q.permissions = values
+	return q
 }
 
 func parseExchangeInfo(jsonContent []byte) (*ExchangeInfo, error) {
@@ -50,8 +82,23 @@ func parseExchangeInfo(jsonContent []byte) (*ExchangeInfo, error) {
 }
 
 func (sdk Sdk) ExchangeInfo() (*ExchangeInfo, error) {
-	request := newRequest("GET", "/api/v1/exchangeInfo")
-	response, err := sdk.client.Do(request)
+	return sdk.ExchangeInfoCtx(context.Background())
+}
+
+func (sdk Sdk) ExchangeInfoCtx(ctx context.Context) (*ExchangeInfo, error) {
+	return sdk.QueryExchangeInfoCtx(ctx, NewExchangeInfoQuery())
+}
+
+func (sdk Sdk) QueryExchangeInfo(query *exchangeInfoQuery) (*ExchangeInfo, error) {
+	return sdk.QueryExchangeInfoCtx(context.Background(), query)
+}
+
+func (sdk Sdk) QueryExchangeInfoCtx(ctx context.Context, query *exchangeInfoQuery) (*ExchangeInfo, error) {
+	request := newRequest("GET", "/api/v3/exchangeInfo").
+		StringParam("symbol", query.symbol).
+		StringsParam("symbols", query.symbols).
+		StringsParam("permissions", query.permissions)
+	response, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -61,5 +108,9 @@ func (sdk Sdk) ExchangeInfo() (*ExchangeInfo, error) {
 		return nil, err
 	}
 
+	if sdk.limiter != nil {
+		sdk.limiter.SetLimits(exchangeInfo.RateLimits)
+	}
+
 	return exchangeInfo, nil
 }
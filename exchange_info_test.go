@@ -5,10 +5,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestSdk_ExchangeInfo(t *testing.T) {
-	method, url := "GET", "/api/v1/exchangeInfo"
+	method, url := "GET", "/api/v3/exchangeInfo"
 
 	t.Run("It should convert api response to an ExchangeInfo", func(t *testing.T) {
 		mockedClient := NewMockClient(gomock.NewController(t))
@@ -18,7 +19,7 @@ func TestSdk_ExchangeInfo(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validExchangeInfoJson(), nil)
 
@@ -26,6 +27,49 @@ func TestSdk_ExchangeInfo(t *testing.T) {
 		assert.Equal(t, validExchangeInfoResponse(), response)
 	})
 
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "ETHBTC").
+			Param("symbols", `["ETHBTC","BNBBTC"]`).
+			Param("permissions", `["SPOT","MARGIN"]`)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validExchangeInfoJson(), nil)
+
+		query := NewExchangeInfoQuery().
+			Symbol("ETHBTC").
+			Symbols("ETHBTC", "BNBBTC").
+			Permissions("SPOT", "MARGIN")
+		response, _ := sdk.QueryExchangeInfo(query)
+
+		assert.Equal(t, validExchangeInfoResponse(), response)
+	})
+
+	t.Run("It should update the limits of the rate limiter", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient, limiter: NewRateLimiter()}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return(validExchangeInfoJson(), nil)
+
+		sdk.ExchangeInfo()
+
+		assert.Equal(t, []RateLimitUsage{
+			{RateLimitType: RateLimitRequestWeight, Interval: time.Minute, Limit: 1200},
+			{RateLimitType: RateLimitOrders, Interval: time.Second, Limit: 10},
+			{RateLimitType: RateLimitOrders, Interval: 24 * time.Hour, Limit: 100000},
+		}, sdk.RateLimiter().Usage())
+	})
+
 	t.Run("It should return error when api fails", func(t *testing.T) {
 		mockedClient := NewMockClient(gomock.NewController(t))
 		sdk := Sdk{client: mockedClient}
@@ -34,7 +78,7 @@ func TestSdk_ExchangeInfo(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -50,7 +94,7 @@ func TestSdk_ExchangeInfo(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -67,16 +111,19 @@ func validExchangeInfoJson() []byte {
  		"rateLimits": [{
      		"rateLimitType": "REQUESTS",
      		"interval": "MINUTE",
+     		"intervalNum": 1,
      		"limit": 1200
    	},
    	{
      		"rateLimitType": "ORDERS",
 			"interval": "SECOND",
+     		"intervalNum": 1,
      		"limit": 10
 		},
    	{
      		"rateLimitType": "ORDERS",
      		"interval": "DAY",
+     		"intervalNum": 1,
      		"limit": 100000
 		}],
  		"exchangeFilters": [],
@@ -87,8 +134,15 @@ func validExchangeInfoJson() []byte {
    		"baseAssetPrecision": 8,
    		"quoteAsset": "BTC",
    		"quotePrecision": 8,
+   		"quoteAssetPrecision": 8,
    		"orderTypes": ["LIMIT", "MARKET"],
    		"icebergAllowed": false,
+   		"ocoAllowed": true,
+   		"isSpotTradingAllowed": true,
+   		"permissions": [],
+   		"permissionSets": [["SPOT", "MARGIN"]],
+   		"defaultSelfTradePreventionMode": "EXPIRE_MAKER",
+   		"allowedSelfTradePreventionModes": ["EXPIRE_TAKER", "EXPIRE_MAKER"],
    		"filters": [{
      			"filterType": "PRICE_FILTER",
      			"minPrice": "0.00000100",
@@ -115,49 +169,56 @@ func validExchangeInfoResponse() *ExchangeInfo {
 			{
 				RateLimitType: "REQUESTS",
 				Interval:      "MINUTE",
+				IntervalNum:   1,
 				Limit:         1200,
 			},
 			{
 				RateLimitType: "ORDERS",
 				Interval:      "SECOND",
+				IntervalNum:   1,
 				Limit:         10,
 			},
 			{
 				RateLimitType: "ORDERS",
 				Interval:      "DAY",
+				IntervalNum:   1,
 				Limit:         100000,
 			},
 		},
-		ExchangeFilters: []string{},
+		ExchangeFilters: Filters{},
 		Symbols: []Symbol{
 			{
-				Symbol:             "ETHBTC",
-				Status:             "TRADING",
-				BaseAsset:          "ETH",
-				BaseAssetPrecision: 8,
-				QuoteAsset:         "BTC",
-				QuotePrecision:     8,
-				OrderTypes: []string{
+				Symbol:              "ETHBTC",
+				Status:              "TRADING",
+				BaseAsset:           "ETH",
+				BaseAssetPrecision:  8,
+				QuoteAsset:          "BTC",
+				QuotePrecision:      8,
+				QuoteAssetPrecision: 8,
+				OrderTypes: []OrderType{
 					"LIMIT",
 					"MARKET",
 				},
-				IcebergAllowed: false,
-				Filters: []Filter{
-					{
-						FilterType: "PRICE_FILTER",
-						MinPrice:   0.000001,
-						MaxPrice:   100000,
-						TickSize:   0.000001,
+				IcebergAllowed:                  false,
+				OcoAllowed:                      true,
+				IsSpotTradingAllowed:            true,
+				Permissions:                     []string{},
+				PermissionSets:                  [][]string{{"SPOT", "MARGIN"}},
+				DefaultSelfTradePreventionMode:  SelfTradePreventionModeExpireMaker,
+				AllowedSelfTradePreventionModes: []SelfTradePreventionMode{SelfTradePreventionModeExpireTaker, SelfTradePreventionModeExpireMaker},
+				Filters: Filters{
+					&PriceFilter{
+						MinPrice: MustDecimal("0.000001"),
+						MaxPrice: MustDecimal("100000"),
+						TickSize: MustDecimal("0.000001"),
 					},
-					{
-						FilterType:  "LOT_SIZE",
-						MinQuantity: 0.001,
-						MaxQuantity: 100000,
-						StepSize:    0.001,
+					&LotSizeFilter{
+						MinQuantity: MustDecimal("0.001"),
+						MaxQuantity: MustDecimal("100000"),
+						StepSize:    MustDecimal("0.001"),
 					},
-					{
-						FilterType:  "MIN_NOTIONAL",
-						MinNotional: 0.001,
+					&MinNotionalFilter{
+						MinNotional: MustDecimal("0.001"),
 					},
 				},
 			},
@@ -0,0 +1,301 @@
+package binance
+
+import "encoding/json"
+
+const (
+	FilterTypePrice              FilterType = "PRICE_FILTER"
+	FilterTypePercentPrice       FilterType = "PERCENT_PRICE"
+	FilterTypePercentPriceBySide FilterType = "PERCENT_PRICE_BY_SIDE"
+	FilterTypeLotSize            FilterType = "LOT_SIZE"
+	FilterTypeMinNotional        FilterType = "MIN_NOTIONAL"
+	FilterTypeNotional           FilterType = "NOTIONAL"
+	FilterTypeIcebergParts       FilterType = "ICEBERG_PARTS"
+	FilterTypeMarketLotSize      FilterType = "MARKET_LOT_SIZE"
+	FilterTypeMaxNumOrders       FilterType = "MAX_NUM_ORDERS"
+	FilterTypeMaxNumAlgoOrders   FilterType = "MAX_NUM_ALGO_ORDERS"
+	FilterTypeMaxNumIceberg      FilterType = "MAX_NUM_ICEBERG_ORDERS"
+	FilterTypeMaxPosition        FilterType = "MAX_POSITION"
+	FilterTypeTrailingDelta      FilterType = "TRAILING_DELTA"
+
+	FilterTypeExchangeMaxNumOrders     FilterType = "EXCHANGE_MAX_NUM_ORDERS"
+	FilterTypeExchangeMaxNumAlgoOrders FilterType = "EXCHANGE_MAX_NUM_ALGO_ORDERS"
+	FilterTypeExchangeMaxNumIceberg    FilterType = "EXCHANGE_MAX_NUM_ICEBERG_ORDERS"
+)
+
+type FilterType string
+
+// Trading rule of a symbol or of the exchange. Each filter type has its own struct, use a type switch or the
+// lookups of Symbol to read them. Filter types unknown to the sdk are decoded as *UnknownFilter.
+type Filter interface {
+	Type() FilterType
+}
+
+// Filters decoded by their filterType.
+type Filters []Filter
+
+// Range and tick size of the price.
+type PriceFilter struct {
+	MinPrice Decimal `json:"minPrice"`
+	MaxPrice Decimal `json:"maxPrice"`
+	TickSize Decimal `json:"tickSize"`
+}
+
+// Range of the price relative to the average price of the last AvgPriceMins minutes.
+type PercentPriceFilter struct {
+	MultiplierUp   Decimal `json:"multiplierUp"`
+	MultiplierDown Decimal `json:"multiplierDown"`
+	AvgPriceMins   int     `json:"avgPriceMins"`
+}
+
+// Range of the price relative to the average price of the last AvgPriceMins minutes, which depends on the side.
+type PercentPriceBySideFilter struct {
+	BidMultiplierUp   Decimal `json:"bidMultiplierUp"`
+	BidMultiplierDown Decimal `json:"bidMultiplierDown"`
+	AskMultiplierUp   Decimal `json:"askMultiplierUp"`
+	AskMultiplierDown Decimal `json:"askMultiplierDown"`
+	AvgPriceMins      int     `json:"avgPriceMins"`
+}
+
+// Range and step size of the quantity.
+type LotSizeFilter struct {
+	MinQuantity Decimal `json:"minQty"`
+	MaxQuantity Decimal `json:"maxQty"`
+	StepSize    Decimal `json:"stepSize"`
+}
+
+// Minimum price * quantity of an order. Market orders use the average price of the last AvgPriceMins minutes.
+type MinNotionalFilter struct {
+	MinNotional   Decimal `json:"minNotional"`
+	ApplyToMarket bool    `json:"applyToMarket"`
+	AvgPriceMins  int     `json:"avgPriceMins"`
+}
+
+// Range of price * quantity of an order. Market orders use the average price of the last AvgPriceMins minutes.
+type NotionalFilter struct {
+	MinNotional      Decimal `json:"minNotional"`
+	ApplyMinToMarket bool    `json:"applyMinToMarket"`
+	MaxNotional      Decimal `json:"maxNotional"`
+	ApplyMaxToMarket bool    `json:"applyMaxToMarket"`
+	AvgPriceMins     int     `json:"avgPriceMins"`
+}
+
+// Maximum number of parts of an iceberg order.
+type IcebergPartsFilter struct {
+	Limit int `json:"limit"`
+}
+
+// Range and step size of the quantity of market orders.
+type MarketLotSizeFilter struct {
+	MinQuantity Decimal `json:"minQty"`
+	MaxQuantity Decimal `json:"maxQty"`
+	StepSize    Decimal `json:"stepSize"`
+}
+
+// Maximum number of open orders of an account on a symbol.
+type MaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
+// Maximum number of open stop orders of an account on a symbol.
+type MaxNumAlgoOrdersFilter struct {
+	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
+}
+
+// Maximum number of open iceberg orders of an account on a symbol.
+type MaxNumIcebergOrdersFilter struct {
+	MaxNumIcebergOrders int `json:"maxNumIcebergOrders"`
+}
+
+// Maximum position of an account on the base asset of a symbol.
+type MaxPositionFilter struct {
+	MaxPosition Decimal `json:"maxPosition"`
+}
+
+// Range of the trailing delta of stop orders, in basis points.
+type TrailingDeltaFilter struct {
+	MinTrailingAboveDelta int64 `json:"minTrailingAboveDelta"`
+	MaxTrailingAboveDelta int64 `json:"maxTrailingAboveDelta"`
+	MinTrailingBelowDelta int64 `json:"minTrailingBelowDelta"`
+	MaxTrailingBelowDelta int64 `json:"maxTrailingBelowDelta"`
+}
+
+// Maximum number of open orders of an account on the exchange.
+type ExchangeMaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
+// Maximum number of open stop orders of an account on the exchange.
+type ExchangeMaxNumAlgoOrdersFilter struct {
+	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
+}
+
+// Maximum number of open iceberg orders of an account on the exchange.
+type ExchangeMaxNumIcebergOrdersFilter struct {
+	MaxNumIcebergOrders int `json:"maxNumIcebergOrders"`
+}
+
+// Filter with a type that the sdk does not know. Data is the json sent by the api.
+type UnknownFilter struct {
+	FilterType FilterType
+	Data       json.RawMessage
+}
+
+func (f *PriceFilter) Type() FilterType                       { return FilterTypePrice }
+func (f *PercentPriceFilter) Type() FilterType                { return FilterTypePercentPrice }
+func (f *PercentPriceBySideFilter) Type() FilterType          { return FilterTypePercentPriceBySide }
+func (f *LotSizeFilter) Type() FilterType                     { return FilterTypeLotSize }
+func (f *MinNotionalFilter) Type() FilterType                 { return FilterTypeMinNotional }
+func (f *NotionalFilter) Type() FilterType                    { return FilterTypeNotional }
+func (f *IcebergPartsFilter) Type() FilterType                { return FilterTypeIcebergParts }
+func (f *MarketLotSizeFilter) Type() FilterType               { return FilterTypeMarketLotSize }
+func (f *MaxNumOrdersFilter) Type() FilterType                { return FilterTypeMaxNumOrders }
+func (f *MaxNumAlgoOrdersFilter) Type() FilterType            { return FilterTypeMaxNumAlgoOrders }
+func (f *MaxNumIcebergOrdersFilter) Type() FilterType         { return FilterTypeMaxNumIceberg }
+func (f *MaxPositionFilter) Type() FilterType                 { return FilterTypeMaxPosition }
+func (f *TrailingDeltaFilter) Type() FilterType               { return FilterTypeTrailingDelta }
+func (f *ExchangeMaxNumOrdersFilter) Type() FilterType        { return FilterTypeExchangeMaxNumOrders }
+func (f *ExchangeMaxNumAlgoOrdersFilter) Type() FilterType    { return FilterTypeExchangeMaxNumAlgoOrders }
+func (f *ExchangeMaxNumIcebergOrdersFilter) Type() FilterType { return FilterTypeExchangeMaxNumIceberg }
+func (f *UnknownFilter) Type() FilterType                     { return f.FilterType }
+
+func newFilter(filterType FilterType) Filter {
+	switch filterType {
+	case FilterTypePrice:
+		return &PriceFilter{}
+	case FilterTypePercentPrice:
+		return &PercentPriceFilter{}
+	case FilterTypePercentPriceBySide:
+		return &PercentPriceBySideFilter{}
+	case FilterTypeLotSize:
+		return &LotSizeFilter{}
+	case FilterTypeMinNotional:
+		return &MinNotionalFilter{}
+	case FilterTypeNotional:
+		return &NotionalFilter{}
+	case FilterTypeIcebergParts:
+		return &IcebergPartsFilter{}
+	case FilterTypeMarketLotSize:
+		return &MarketLotSizeFilter{}
+	case FilterTypeMaxNumOrders:
+		return &MaxNumOrdersFilter{}
+	case FilterTypeMaxNumAlgoOrders:
+		return &MaxNumAlgoOrdersFilter{}
+	case FilterTypeMaxNumIceberg:
+		return &MaxNumIcebergOrdersFilter{}
+	case FilterTypeMaxPosition:
+		return &MaxPositionFilter{}
+	case FilterTypeTrailingDelta:
+		return &TrailingDeltaFilter{}
+	case FilterTypeExchangeMaxNumOrders:
+		return &ExchangeMaxNumOrdersFilter{}
+	case FilterTypeExchangeMaxNumAlgoOrders:
+		return &ExchangeMaxNumAlgoOrdersFilter{}
+	case FilterTypeExchangeMaxNumIceberg:
+		return &ExchangeMaxNumIcebergOrdersFilter{}
+	}
+	return &UnknownFilter{FilterType: filterType}
+}
+
+// Decodes each filter in the struct of its filterType.
+func (f *Filters) UnmarshalJSON(data []byte) error {
+	items := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	filters := make(Filters, 0, len(items))
+	for _, item := range items {
+		head := struct {
+			FilterType FilterType `json:"filterType"`
+		}{}
+		if err := json.Unmarshal(item, &head); err != nil {
+			return err
+		}
+
+		filter := newFilter(head.FilterType)
+		if unknown, ok := filter.(*UnknownFilter); ok {
+			unknown.Data = item
+		} else if err := json.Unmarshal(item, filter); err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+
+	*f = filters
+	return nil
+}
+
+// First filter of the given type or nil when there is none.
+func (f Filters) Find(filterType FilterType) Filter {
+	for _, filter := range f {
+		if filter.Type() == filterType {
+			return filter
+		}
+	}
+	return nil
+}
+
+func (s Symbol) PriceFilter() *PriceFilter {
+	filter, _ := s.Filters.Find(FilterTypePrice).(*PriceFilter)
+	return filter
+}
+
+func (s Symbol) PercentPrice() *PercentPriceFilter {
+	filter, _ := s.Filters.Find(FilterTypePercentPrice).(*PercentPriceFilter)
+	return filter
+}
+
+func (s Symbol) PercentPriceBySide() *PercentPriceBySideFilter {
+	filter, _ := s.Filters.Find(FilterTypePercentPriceBySide).(*PercentPriceBySideFilter)
+	return filter
+}
+
+func (s Symbol) LotSize() *LotSizeFilter {
+	filter, _ := s.Filters.Find(FilterTypeLotSize).(*LotSizeFilter)
+	return filter
+}
+
+func (s Symbol) MinNotional() *MinNotionalFilter {
+	filter, _ := s.Filters.Find(FilterTypeMinNotional).(*MinNotionalFilter)
+	return filter
+}
+
+func (s Symbol) Notional() *NotionalFilter {
+	filter, _ := s.Filters.Find(FilterTypeNotional).(*NotionalFilter)
+	return filter
+}
+
+func (s Symbol) IcebergParts() *IcebergPartsFilter {
+	filter, _ := s.Filters.Find(FilterTypeIcebergParts).(*IcebergPartsFilter)
+	return filter
+}
+
+func (s Symbol) MarketLotSize() *MarketLotSizeFilter {
+	filter, _ := s.Filters.Find(FilterTypeMarketLotSize).(*MarketLotSizeFilter)
+	return filter
+}
+
+func (s Symbol) MaxNumOrders() *MaxNumOrdersFilter {
+	filter, _ := s.Filters.Find(FilterTypeMaxNumOrders).(*MaxNumOrdersFilter)
+	return filter
+}
+
+func (s Symbol) MaxNumAlgoOrders() *MaxNumAlgoOrdersFilter {
+	filter, _ := s.Filters.Find(FilterTypeMaxNumAlgoOrders).(*MaxNumAlgoOrdersFilter)
+	return filter
+}
+
+func (s Symbol) MaxNumIcebergOrders() *MaxNumIcebergOrdersFilter {
+	filter, _ := s.Filters.Find(FilterTypeMaxNumIceberg).(*MaxNumIcebergOrdersFilter)
+	return filter
+}
+
+func (s Symbol) MaxPosition() *MaxPositionFilter {
+	filter, _ := s.Filters.Find(FilterTypeMaxPosition).(*MaxPositionFilter)
+	return filter
+}
+
+func (s Symbol) TrailingDelta() *TrailingDeltaFilter {
+	filter, _ := s.Filters.Find(FilterTypeTrailingDelta).(*TrailingDeltaFilter)
+	return filter
+}
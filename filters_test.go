@@ -0,0 +1,140 @@
+package binance
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFilters_UnmarshalJSON(t *testing.T) {
+	t.Run("It should decode each filter in the struct of its type", func(t *testing.T) {
+		filters := Filters{}
+		err := json.Unmarshal(validFiltersJson(), &filters)
+
+		assert.Nil(t, err)
+		assert.Equal(t, validFiltersResponse(), filters)
+	})
+
+	t.Run("It should keep the json of unknown filters", func(t *testing.T) {
+		filters := Filters{}
+		err := json.Unmarshal([]byte(`[{"filterType": "NEW_FILTER", "value": "1"}]`), &filters)
+
+		assert.Nil(t, err)
+		assert.Equal(t, Filters{
+			&UnknownFilter{FilterType: "NEW_FILTER", Data: json.RawMessage(`{"filterType": "NEW_FILTER", "value": "1"}`)},
+		}, filters)
+	})
+
+	t.Run("It should return error when a filter cannot be mapped", func(t *testing.T) {
+		filters := Filters{}
+		err := json.Unmarshal([]byte(`[{"filterType": "LOT_SIZE", "minQty": true}]`), &filters)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSymbol_Filters(t *testing.T) {
+	t.Run("It should find the filters of the symbol by type", func(t *testing.T) {
+		symbol := Symbol{Filters: validFiltersResponse()}
+
+		assert.Equal(t, validFiltersResponse()[0], symbol.PriceFilter())
+		assert.Equal(t, validFiltersResponse()[1], symbol.PercentPrice())
+		assert.Equal(t, validFiltersResponse()[2], symbol.PercentPriceBySide())
+		assert.Equal(t, validFiltersResponse()[3], symbol.LotSize())
+		assert.Equal(t, validFiltersResponse()[4], symbol.MinNotional())
+		assert.Equal(t, validFiltersResponse()[5], symbol.Notional())
+		assert.Equal(t, validFiltersResponse()[6], symbol.IcebergParts())
+		assert.Equal(t, validFiltersResponse()[7], symbol.MarketLotSize())
+		assert.Equal(t, validFiltersResponse()[8], symbol.MaxNumOrders())
+		assert.Equal(t, validFiltersResponse()[9], symbol.MaxNumAlgoOrders())
+		assert.Equal(t, validFiltersResponse()[10], symbol.MaxNumIcebergOrders())
+		assert.Equal(t, validFiltersResponse()[11], symbol.MaxPosition())
+		assert.Equal(t, validFiltersResponse()[12], symbol.TrailingDelta())
+	})
+
+	t.Run("It should return nil when the symbol has no filter of the type", func(t *testing.T) {
+		symbol := Symbol{}
+
+		assert.Nil(t, symbol.LotSize())
+		assert.Nil(t, symbol.Filters.Find(FilterTypePrice))
+	})
+}
+
+func validFiltersJson() []byte {
+	return []byte(`[
+		{"filterType": "PRICE_FILTER", "minPrice": "0.01", "maxPrice": "1000000", "tickSize": "0.01"},
+		{"filterType": "PERCENT_PRICE", "multiplierUp": "5", "multiplierDown": "0.2", "avgPriceMins": 5},
+		{
+			"filterType": "PERCENT_PRICE_BY_SIDE",
+			"bidMultiplierUp": "5",
+			"bidMultiplierDown": "0.2",
+			"askMultiplierUp": "4",
+			"askMultiplierDown": "0.8",
+			"avgPriceMins": 1
+		},
+		{"filterType": "LOT_SIZE", "minQty": "0.0001", "maxQty": "9000", "stepSize": "0.0001"},
+		{"filterType": "MIN_NOTIONAL", "minNotional": "10", "applyToMarket": true, "avgPriceMins": 5},
+		{
+			"filterType": "NOTIONAL",
+			"minNotional": "5",
+			"applyMinToMarket": true,
+			"maxNotional": "9000000",
+			"applyMaxToMarket": false,
+			"avgPriceMins": 5
+		},
+		{"filterType": "ICEBERG_PARTS", "limit": 10},
+		{"filterType": "MARKET_LOT_SIZE", "minQty": "0", "maxQty": "120", "stepSize": "0"},
+		{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200},
+		{"filterType": "MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 5},
+		{"filterType": "MAX_NUM_ICEBERG_ORDERS", "maxNumIcebergOrders": 5},
+		{"filterType": "MAX_POSITION", "maxPosition": "10"},
+		{
+			"filterType": "TRAILING_DELTA",
+			"minTrailingAboveDelta": 10,
+			"maxTrailingAboveDelta": 2000,
+			"minTrailingBelowDelta": 10,
+			"maxTrailingBelowDelta": 2000
+		},
+		{"filterType": "EXCHANGE_MAX_NUM_ORDERS", "maxNumOrders": 1000},
+		{"filterType": "EXCHANGE_MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 200},
+		{"filterType": "EXCHANGE_MAX_NUM_ICEBERG_ORDERS", "maxNumIcebergOrders": 10000}
+	]`)
+}
+
+func validFiltersResponse() Filters {
+	return Filters{
+		&PriceFilter{MinPrice: MustDecimal("0.01"), MaxPrice: MustDecimal("1000000"), TickSize: MustDecimal("0.01")},
+		&PercentPriceFilter{MultiplierUp: MustDecimal("5"), MultiplierDown: MustDecimal("0.2"), AvgPriceMins: 5},
+		&PercentPriceBySideFilter{
+			BidMultiplierUp:   MustDecimal("5"),
+			BidMultiplierDown: MustDecimal("0.2"),
+			AskMultiplierUp:   MustDecimal("4"),
+			AskMultiplierDown: MustDecimal("0.8"),
+			AvgPriceMins:      1,
+		},
+		&LotSizeFilter{MinQuantity: MustDecimal("0.0001"), MaxQuantity: MustDecimal("9000"), StepSize: MustDecimal("0.0001")},
+		&MinNotionalFilter{MinNotional: MustDecimal("10"), ApplyToMarket: true, AvgPriceMins: 5},
+		&NotionalFilter{
+			MinNotional:      MustDecimal("5"),
+			ApplyMinToMarket: true,
+			MaxNotional:      MustDecimal("9000000"),
+			ApplyMaxToMarket: false,
+			AvgPriceMins:     5,
+		},
+		&IcebergPartsFilter{Limit: 10},
+		&MarketLotSizeFilter{MinQuantity: MustDecimal("0"), MaxQuantity: MustDecimal("120"), StepSize: MustDecimal("0")},
+		&MaxNumOrdersFilter{MaxNumOrders: 200},
+		&MaxNumAlgoOrdersFilter{MaxNumAlgoOrders: 5},
+		&MaxNumIcebergOrdersFilter{MaxNumIcebergOrders: 5},
+		&MaxPositionFilter{MaxPosition: MustDecimal("10")},
+		&TrailingDeltaFilter{
+			MinTrailingAboveDelta: 10,
+			MaxTrailingAboveDelta: 2000,
+			MinTrailingBelowDelta: 10,
+			MaxTrailingBelowDelta: 2000,
+		},
+		&ExchangeMaxNumOrdersFilter{MaxNumOrders: 1000},
+		&ExchangeMaxNumAlgoOrdersFilter{MaxNumAlgoOrders: 200},
+		&ExchangeMaxNumIcebergOrdersFilter{MaxNumIcebergOrders: 10000},
+	}
+}
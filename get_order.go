@@ -1,24 +1,37 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
 type Order struct {
-	Symbol           string  `json:"symbol"`
-	OrderId          int64   `json:"orderId"`
-	ClientOrderId    string  `json:"clientOrderId"`
-	Price            float64 `json:"price,string"`
-	OriginalQuantity float64 `json:"origQty,string"`
-	ExecutedQuantity float64 `json:"executedQty,string"`
-	Status           string  `json:"status"`
-	TimeInForce      string  `json:"timeInForce"`
-	Type             string  `json:"type"`
-	Side             string  `json:"side"`
-	StopPrice        float64 `json:"stopPrice,string"`
-	IcebergQuantity  float64 `json:"icebergQty,string"`
-	Time             int64   `json:"time"`
-	IsWorking        bool    `json:"isWorking"`
+	Symbol                     string                  `json:"symbol"`
+	OrderId                    int64                   `json:"orderId"`
+	ClientOrderId              string                  `json:"clientOrderId"`
+	Price                      Decimal                 `json:"price"`
+	OriginalQuantity           Decimal                 `json:"origQty"`
+	ExecutedQuantity           Decimal                 `json:"executedQty"`
+	Status                     OrderStatus             `json:"status"`
+	TimeInForce                TimeInForce             `json:"timeInForce"`
+	Type                       OrderType               `json:"type"`
+	Side                       OrderSide               `json:"side"`
+	StopPrice                  Decimal                 `json:"stopPrice"`
+	IcebergQuantity            Decimal                 `json:"icebergQty"`
+	Time                       int64                   `json:"time"`
+	IsWorking                  bool                    `json:"isWorking"`
+	OrderListId                int64                   `json:"orderListId"`
+	OriginalQuoteOrderQuantity Decimal                 `json:"origQuoteOrderQty"`
+	CumulativeQuoteQuantity    Decimal                 `json:"cummulativeQuoteQty"`
+	UpdateTime                 int64                   `json:"updateTime"`
+	WorkingTime                int64                   `json:"workingTime"`
+	TrailingDelta              int64                   `json:"trailingDelta"`
+	TrailingTime               int64                   `json:"trailingTime"`
+	StrategyId                 int64                   `json:"strategyId"`
+	StrategyType               int64                   `json:"strategyType"`
+	SelfTradePreventionMode    SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	PegPriceType               PegPriceType            `json:"pegPriceType"`
+	PeggedPrice                Decimal                 `json:"peggedPrice"`
 }
 
 type getOrderQuery struct {
@@ -51,6 +64,10 @@ func (r *getOrderQuery) RecvWindow(value int64) *getOrderQuery {
 }
 
 func (sdk Sdk) GetOrder(query *getOrderQuery) (*Order, error) {
+	return sdk.GetOrderCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetOrderCtx(ctx context.Context, query *getOrderQuery) (*Order, error) {
 	req := newRequest("GET", "/api/v3/order").
 		StringParam("symbol", query.symbol).
 		Int64Param("orderId", query.orderId).
@@ -59,7 +76,7 @@ func (sdk Sdk) GetOrder(query *getOrderQuery) (*Order, error) {
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
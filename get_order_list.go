@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Group of orders placed together, like the two orders of an OCO. Orders only links the orders of the list, use
+// GetOrder to query them.
+type OrderList struct {
+	OrderListId       int64            `json:"orderListId"`
+	ContingencyType   ContingencyType  `json:"contingencyType"`
+	ListStatusType    ListStatusType   `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatus  `json:"listOrderStatus"`
+	ListClientOrderId string           `json:"listClientOrderId"`
+	TransactionTime   int64            `json:"transactionTime"`
+	Symbol            string           `json:"symbol"`
+	Orders            []OrderListOrder `json:"orders"`
+}
+
+type OrderListOrder struct {
+	Symbol        string `json:"symbol"`
+	OrderId       int64  `json:"orderId"`
+	ClientOrderId string `json:"clientOrderId"`
+}
+
+// Order list returned when it is placed or canceled, with the state of each one of its orders.
+type OrderListReport struct {
+	OrderList
+	OrderReports []OrderReport `json:"orderReports"`
+}
+
+// State of an order after it has been placed in a list or canceled. Fills are only included when the list is placed
+// with the FULL response type.
+type OrderReport struct {
+	FullOrder
+	OrigClientOrderId string `json:"origClientOrderId"`
+}
+
+type getOrderListQuery struct {
+	orderListId       *int64
+	origClientOrderId *string
+	recvWindow        *int64
+	timestamp         *int64
+}
+
+// Either the order list id or the original client order id must be set.
+func NewGetOrderListQuery() *getOrderListQuery {
+	return &getOrderListQuery{}
+}
+
+func (r *getOrderListQuery) OrderListId(value int64) *getOrderListQuery {
+	r.orderListId = &value
+	return r
+}
+
+func (r *getOrderListQuery) OrigClientOrderId(value string) *getOrderListQuery {
+	r.origClientOrderId = &value
+	return r
+}
+
+func (r *getOrderListQuery) RecvWindow(value int64) *getOrderListQuery {
+	r.recvWindow = &value
+	return r
+}
+
+func (sdk Sdk) GetOrderList(query *getOrderListQuery) (*OrderList, error) {
+	return sdk.GetOrderListCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetOrderListCtx(ctx context.Context, query *getOrderListQuery) (*OrderList, error) {
+	req := newRequest("GET", "/api/v3/orderList").
+		Int64Param("orderListId", query.orderListId).
+		StringParam("origClientOrderId", query.origClientOrderId).
+		Int64Param("recvWindow", query.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListResponse(responseContent)
+}
+
+func parseOrderListResponse(jsonContent []byte) (*OrderList, error) {
+	response := &OrderList{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
+
+func parseOrderListsResponse(jsonContent []byte) ([]OrderList, error) {
+	response := make([]OrderList, 0)
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
+
+func parseOrderListReportResponse(jsonContent []byte) (*OrderListReport, error) {
+	response := &OrderListReport{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
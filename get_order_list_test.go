@@ -0,0 +1,244 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_GetOrderList(t *testing.T) {
+	method, url := "GET", "/api/v3/orderList"
+
+	t.Run("It should convert api response to an OrderList", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("orderListId", "27").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListJson(), nil)
+
+		response, _ := sdk.GetOrderList(NewGetOrderListQuery().OrderListId(27))
+
+		assert.Equal(t, validOrderListResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("origClientOrderId", "h2USkA5YQpaXHPIrkd96xE").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListJson(), nil)
+
+		query := NewGetOrderListQuery().OrigClientOrderId("h2USkA5YQpaXHPIrkd96xE").RecvWindow(2)
+		response, _ := sdk.GetOrderList(query)
+
+		assert.Equal(t, validOrderListResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.GetOrderList(NewGetOrderListQuery().OrderListId(27))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.GetOrderList(NewGetOrderListQuery().OrderListId(27))
+
+		assert.Error(t, err)
+	})
+}
+
+func validOrderListJson() []byte {
+	return []byte(`{
+		"orderListId": 27,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+		"transactionTime": 1565245656253,
+		"symbol": "LTCBTC",
+		"orders": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 4,
+				"clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 5,
+				"clientOrderId": "ARzZ9I00CPM8i3NhmU9Ega"
+			}
+		]
+	}`)
+}
+
+func validOrderListResponse() *OrderList {
+	return &OrderList{
+		OrderListId:       27,
+		ContingencyType:   ContingencyTypeOCO,
+		ListStatusType:    ListStatusTypeExecStarted,
+		ListOrderStatus:   ListOrderStatusExecuting,
+		ListClientOrderId: "h2USkA5YQpaXHPIrkd96xE",
+		TransactionTime:   1565245656253,
+		Symbol:            "LTCBTC",
+		Orders: []OrderListOrder{
+			{Symbol: "LTCBTC", OrderId: 4, ClientOrderId: "qD1gy3kc3Gx0rihm9Y3xwS"},
+			{Symbol: "LTCBTC", OrderId: 5, ClientOrderId: "ARzZ9I00CPM8i3NhmU9Ega"},
+		},
+	}
+}
+
+func validOrderListReportJson() []byte {
+	return []byte(`{
+		"orderListId": 27,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+		"transactionTime": 1565245656253,
+		"symbol": "LTCBTC",
+		"orders": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 4,
+				"clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 5,
+				"clientOrderId": "ARzZ9I00CPM8i3NhmU9Ega"
+			}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 4,
+				"orderListId": 27,
+				"clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS",
+				"transactTime": 1565245656253,
+				"price": "1.00000000",
+				"origQty": "1.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "STOP_LOSS_LIMIT",
+				"side": "SELL",
+				"stopPrice": "1.50000000",
+				"workingTime": -1,
+				"selfTradePreventionMode": "NONE"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 5,
+				"orderListId": 27,
+				"clientOrderId": "ARzZ9I00CPM8i3NhmU9Ega",
+				"transactTime": 1565245656253,
+				"price": "3.00000000",
+				"origQty": "1.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT_MAKER",
+				"side": "SELL",
+				"workingTime": 1565245656253,
+				"selfTradePreventionMode": "NONE"
+			}
+		]
+	}`)
+}
+
+func validOrderListReportResponse() *OrderListReport {
+	report := func(orderId int64, clientOrderId string, price string, orderType OrderType, workingTime int64) OrderResult {
+		return OrderResult{
+			OrderAck: OrderAck{
+				Symbol:          "LTCBTC",
+				OrderId:         orderId,
+				OrderListId:     27,
+				ClientOrderId:   clientOrderId,
+				TransactionTime: 1565245656253,
+			},
+			Price:                   MustDecimal(price),
+			OriginalQuantity:        MustDecimal("1"),
+			Status:                  OrderStatusNew,
+			TimeInForce:             TimeInForceGTC,
+			Type:                    orderType,
+			Side:                    SideSell,
+			WorkingTime:             workingTime,
+			SelfTradePreventionMode: "NONE",
+		}
+	}
+
+	stopLossReport := report(4, "qD1gy3kc3Gx0rihm9Y3xwS", "1", OrderTypeStopLossLimit, -1)
+	stopLossReport.StopPrice = MustDecimal("1.5")
+
+	return &OrderListReport{
+		OrderList: *validOrderListResponse(),
+		OrderReports: []OrderReport{
+			{
+				FullOrder: FullOrder{OrderResult: stopLossReport},
+			},
+			{
+				FullOrder: FullOrder{OrderResult: report(5, "ARzZ9I00CPM8i3NhmU9Ega", "3", OrderTypeLimitMaker, 1565245656253)},
+			},
+		},
+	}
+}
@@ -28,7 +28,7 @@ func TestSdk_GetOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validOrderJson(), nil)
 
@@ -57,7 +57,7 @@ func TestSdk_GetOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validOrderJson(), nil)
 
@@ -87,7 +87,7 @@ func TestSdk_GetOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -113,7 +113,7 @@ func TestSdk_GetOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -139,25 +139,41 @@ func validOrderJson() []byte {
   		"stopPrice": "0.0",
   		"icebergQty": "0.0",
   		"time": 1499827319559,
-  		"isWorking": true
+  		"isWorking": true,
+  		"orderListId": -1,
+  		"origQuoteOrderQty": "0.000000",
+  		"cummulativeQuoteQty": "0.0",
+  		"updateTime": 1499827319559,
+  		"workingTime": 1499827319559,
+  		"trailingDelta": 100,
+  		"strategyId": 1,
+  		"strategyType": 1000000,
+  		"selfTradePreventionMode": "NONE"
 	}`)
 }
 
 func validOrderResponse() *Order {
 	return &Order{
-		Symbol:           "LTCBTC",
-		OrderId:          1,
-		ClientOrderId:    "myOrder1",
-		Price:            0.1,
-		OriginalQuantity: 1,
-		ExecutedQuantity: 0,
-		Status:           "NEW",
-		TimeInForce:      "GTC",
-		Type:             "LIMIT",
-		Side:             "BUY",
-		StopPrice:        0.0,
-		IcebergQuantity:  0.0,
-		Time:             1499827319559,
-		IsWorking:        true,
+		Symbol:                  "LTCBTC",
+		OrderId:                 1,
+		ClientOrderId:           "myOrder1",
+		Price:                   MustDecimal("0.1"),
+		OriginalQuantity:        MustDecimal("1"),
+		ExecutedQuantity:        MustDecimal("0"),
+		Status:                  "NEW",
+		TimeInForce:             "GTC",
+		Type:                    "LIMIT",
+		Side:                    "BUY",
+		StopPrice:               MustDecimal("0.0"),
+		IcebergQuantity:         MustDecimal("0.0"),
+		Time:                    1499827319559,
+		IsWorking:               true,
+		OrderListId:             -1,
+		UpdateTime:              1499827319559,
+		WorkingTime:             1499827319559,
+		TrailingDelta:           100,
+		StrategyId:              1,
+		StrategyType:            1000000,
+		SelfTradePreventionMode: SelfTradePreventionModeNone,
 	}
 }
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
@@ -26,16 +27,16 @@ type Interval string
 
 type KLine struct {
 	OpenTime            int
-	Open                float64
-	High                float64
-	Low                 float64
-	Close               float64
-	Volume              float64
+	Open                Decimal
+	High                Decimal
+	Low                 Decimal
+	Close               Decimal
+	Volume              Decimal
 	CloseTime           int
-	QuoteAssetVolume    float64
+	QuoteAssetVolume    Decimal
 	TradesNumber        int
-	TakerBuyBaseVolume  float64
-	TakerBuyQuoteVolume float64
+	TakerBuyBaseVolume  Decimal
+	TakerBuyQuoteVolume Decimal
 }
 
 type kLinesQuery struct {
@@ -70,14 +71,14 @@ func parseKLinesResponse(jsonContent []byte) ([]KLine, error) {
 
 	kLines := make([]KLine, 0)
 	for _, item := range elements {
-		open, _ := strconv.ParseFloat(item[1].(string), 64)
-		high, _ := strconv.ParseFloat(item[2].(string), 64)
-		low, _ := strconv.ParseFloat(item[3].(string), 64)
-		close, _ := strconv.ParseFloat(item[4].(string), 64)
-		volume, _ := strconv.ParseFloat(item[5].(string), 64)
-		quoteVolume, _ := strconv.ParseFloat(item[7].(string), 64)
-		takerBuyBaseVolume, _ := strconv.ParseFloat(item[9].(string), 64)
-		takerBuyQuoteVolume, _ := strconv.ParseFloat(item[10].(string), 64)
+		open, _ := NewDecimalFromString(item[1].(string))
+		high, _ := NewDecimalFromString(item[2].(string))
+		low, _ := NewDecimalFromString(item[3].(string))
+		close, _ := NewDecimalFromString(item[4].(string))
+		volume, _ := NewDecimalFromString(item[5].(string))
+		quoteVolume, _ := NewDecimalFromString(item[7].(string))
+		takerBuyBaseVolume, _ := NewDecimalFromString(item[9].(string))
+		takerBuyQuoteVolume, _ := NewDecimalFromString(item[10].(string))
 
 		kLines = append(kLines, KLine{
 			OpenTime:            int(item[0].(float64)),
@@ -98,6 +99,10 @@ func parseKLinesResponse(jsonContent []byte) ([]KLine, error) {
 }
 
 func (sdk *Sdk) KLines(query *kLinesQuery) ([]KLine, error) {
+	return sdk.KLinesCtx(context.Background(), query)
+}
+
+func (sdk *Sdk) KLinesCtx(ctx context.Context, query *kLinesQuery) ([]KLine, error) {
 	request := newRequest("GET", "/api/v1/klines").
 		Param("symbol", query.symbol).
 		Param("interval", string(query.interval))
@@ -114,7 +119,7 @@ func (sdk *Sdk) KLines(query *kLinesQuery) ([]KLine, error) {
 		request.Param("endTime", strconv.Itoa(query.endTime))
 	}
 
-	responseContent, err := sdk.client.Do(request)
+	responseContent, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
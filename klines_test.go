@@ -20,7 +20,7 @@ func TestSdk_KLines(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validKLinesJson(), nil)
 
@@ -42,7 +42,7 @@ func TestSdk_KLines(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validKLinesJson(), nil)
 
@@ -62,7 +62,7 @@ func TestSdk_KLines(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -81,7 +81,7 @@ func TestSdk_KLines(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -113,15 +113,15 @@ func validKLinesJson() []byte {
 func validKLinesResponse() []KLine {
 	return []KLine{{
 		OpenTime:            1499040000000,
-		Open:                0.01634790,
-		High:                0.8,
-		Low:                 0.015758,
-		Close:               0.015771,
-		Volume:              148976.11427815,
+		Open:                MustDecimal("0.01634790"),
+		High:                MustDecimal("0.8"),
+		Low:                 MustDecimal("0.015758"),
+		Close:               MustDecimal("0.015771"),
+		Volume:              MustDecimal("148976.11427815"),
 		CloseTime:           1499644799999,
-		QuoteAssetVolume:    2434.19055334,
+		QuoteAssetVolume:    MustDecimal("2434.19055334"),
 		TradesNumber:        308,
-		TakerBuyBaseVolume:  1756.87402397,
-		TakerBuyQuoteVolume: 28.46694368,
+		TakerBuyBaseVolume:  MustDecimal("1756.87402397"),
+		TakerBuyQuoteVolume: MustDecimal("28.46694368"),
 	}}
 }
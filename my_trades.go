@@ -1,13 +1,16 @@
 package binance
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type AccountTrade struct {
 	Id              int64   `json:"id"`
 	OrderId         int64   `json:"orderId"`
-	Price           float64 `json:"price,string"`
-	Quantity        float64 `json:"qty,string"`
-	Commission      float64 `json:"commission,string"`
+	Price           Decimal `json:"price"`
+	Quantity        Decimal `json:"qty"`
+	Commission      Decimal `json:"commission"`
 	CommissionAsset string  `json:"commissionAsset"`
 	Time            int64   `json:"time"`
 	IsBuyer         bool    `json:"isBuyer"`
@@ -42,15 +45,19 @@ func NewMyTradesQuery(symbol string) *myTradesQuery {
 }
 
 func (sdk Sdk) MyTrades(query *myTradesQuery) ([]AccountTrade, error) {
+	return sdk.MyTradesCtx(context.Background(), query)
+}
+
+func (sdk Sdk) MyTradesCtx(ctx context.Context, query *myTradesQuery) ([]AccountTrade, error) {
 	req := newRequest("GET", "/api/v3/myTrades").
 		StringParam("symbol", query.symbol).
-		Int64Param("recvWindow", query.recvWindow).
 		Int64Param("limit", query.limit).
 		Int64Param("fromId", query.fromId).
+		Int64Param("recvWindow", query.recvWindow).
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
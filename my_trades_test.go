@@ -28,7 +28,7 @@ func TestSdk_MyTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAccountTradesJson(), nil)
 
@@ -57,7 +57,7 @@ func TestSdk_MyTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAccountTradesJson(), nil)
 
@@ -83,7 +83,7 @@ func TestSdk_MyTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -109,7 +109,7 @@ func TestSdk_MyTrades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -141,9 +141,9 @@ func validAccountTradesResponse() []AccountTrade {
 	return []AccountTrade{{
 		Id:              28457,
 		OrderId:         100234,
-		Price:           4.000001,
-		Quantity:        12,
-		Commission:      10.1,
+		Price:           MustDecimal("4.000001"),
+		Quantity:        MustDecimal("12"),
+		Commission:      MustDecimal("10.1"),
 		CommissionAsset: "BNB",
 		Time:            1499865549590,
 		IsBuyer:         true,
@@ -0,0 +1,152 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+)
+
+type newOCORequest struct {
+	symbol               *string
+	side                 *OrderSide
+	quantity             *Decimal
+	above                orderListLeg
+	below                orderListLeg
+	listClientOrderId    *string
+	newOrderResponseType *NewOrderResponseType
+	recvWindow           *int64
+	timestamp            *int64
+}
+
+// One-cancels-the-other order: two orders of the same side and quantity, one above and one below the last price,
+// where the fill of one of them cancels the other. For example, a take profit above and a stop loss below the price
+// to sell a position.
+func NewOCORequest(symbol string, side OrderSide, quantity Decimal, aboveType OrderType, belowType OrderType) *newOCORequest {
+	return &newOCORequest{
+		symbol:   &symbol,
+		side:     &side,
+		quantity: &quantity,
+		above:    orderListLeg{orderType: &aboveType},
+		below:    orderListLeg{orderType: &belowType},
+	}
+}
+
+func (r *newOCORequest) ListClientOrderId(value string) *newOCORequest {
+	r.listClientOrderId = &value
+	return r
+}
+
+func (r *newOCORequest) AboveClientOrderId(value string) *newOCORequest {
+	r.above.clientOrderId = &value
+	return r
+}
+
+func (r *newOCORequest) AbovePrice(value Decimal) *newOCORequest {
+	r.above.price = &value
+	return r
+}
+
+func (r *newOCORequest) AboveStopPrice(value Decimal) *newOCORequest {
+	r.above.stopPrice = &value
+	return r
+}
+
+func (r *newOCORequest) AboveTrailingDelta(value int64) *newOCORequest {
+	r.above.trailingDelta = &value
+	return r
+}
+
+func (r *newOCORequest) AboveIcebergQuantity(value Decimal) *newOCORequest {
+	r.above.icebergQuantity = &value
+	return r
+}
+
+func (r *newOCORequest) AboveTimeInForce(value TimeInForce) *newOCORequest {
+	r.above.timeInForce = &value
+	return r
+}
+
+func (r *newOCORequest) BelowClientOrderId(value string) *newOCORequest {
+	r.below.clientOrderId = &value
+	return r
+}
+
+func (r *newOCORequest) BelowPrice(value Decimal) *newOCORequest {
+	r.below.price = &value
+	return r
+}
+
+func (r *newOCORequest) BelowStopPrice(value Decimal) *newOCORequest {
+	r.below.stopPrice = &value
+	return r
+}
+
+func (r *newOCORequest) BelowTrailingDelta(value int64) *newOCORequest {
+	r.below.trailingDelta = &value
+	return r
+}
+
+func (r *newOCORequest) BelowIcebergQuantity(value Decimal) *newOCORequest {
+	r.below.icebergQuantity = &value
+	return r
+}
+
+func (r *newOCORequest) BelowTimeInForce(value TimeInForce) *newOCORequest {
+	r.below.timeInForce = &value
+	return r
+}
+
+func (r *newOCORequest) NewOrderResponseType(value NewOrderResponseType) *newOCORequest {
+	r.newOrderResponseType = &value
+	return r
+}
+
+func (r *newOCORequest) RecvWindow(value int64) *newOCORequest {
+	r.recvWindow = &value
+	return r
+}
+
+// Rejects the values of the enums that the api does not accept, so typos fail before sending the order list.
+func (r *newOCORequest) validate() error {
+	if !r.side.IsValid() {
+		return fmt.Errorf("invalid order side %q", *r.side)
+	}
+	if err := r.above.validate("above"); err != nil {
+		return err
+	}
+	if err := r.below.validate("below"); err != nil {
+		return err
+	}
+	if r.newOrderResponseType != nil && !r.newOrderResponseType.IsValid() {
+		return fmt.Errorf("invalid new order response type %q", *r.newOrderResponseType)
+	}
+	return nil
+}
+
+func (sdk Sdk) NewOCO(request *newOCORequest) (*OrderListReport, error) {
+	return sdk.NewOCOCtx(context.Background(), request)
+}
+
+func (sdk Sdk) NewOCOCtx(ctx context.Context, request *newOCORequest) (*OrderListReport, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	req := newRequest("POST", "/api/v3/orderList/oco").
+		StringParam("symbol", request.symbol).
+		StringParam("side", (*string)(request.side)).
+		DecimalParam("quantity", request.quantity).
+		StringParam("listClientOrderId", request.listClientOrderId)
+	req = request.above.addParams("above", req)
+	req = request.below.addParams("below", req).
+		StringParam("newOrderRespType", (*string)(request.newOrderResponseType)).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListReportResponse(responseContent)
+}
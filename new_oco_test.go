@@ -0,0 +1,172 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_NewOCO(t *testing.T) {
+	method, url := "POST", "/api/v3/orderList/oco"
+
+	t.Run("It should convert api response to an OrderListReport", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("side", "SELL").
+			Param("quantity", "1").
+			Param("aboveType", "LIMIT_MAKER").
+			Param("abovePrice", "3").
+			Param("belowType", "STOP_LOSS_LIMIT").
+			Param("belowPrice", "1").
+			Param("belowStopPrice", "1.5").
+			Param("belowTimeInForce", "GTC").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		request := NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLossLimit).
+			AbovePrice(MustDecimal("3")).
+			BelowPrice(MustDecimal("1")).
+			BelowStopPrice(MustDecimal("1.5")).
+			BelowTimeInForce(TimeInForceGTC)
+		response, _ := sdk.NewOCO(request)
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("side", "BUY").
+			Param("quantity", "1").
+			Param("listClientOrderId", "h2USkA5YQpaXHPIrkd96xE").
+			Param("aboveType", "STOP_LOSS").
+			Param("aboveClientOrderId", "above").
+			Param("aboveStopPrice", "3").
+			Param("aboveTrailingDelta", "100").
+			Param("belowType", "LIMIT_MAKER").
+			Param("belowClientOrderId", "below").
+			Param("belowPrice", "1").
+			Param("belowIcebergQty", "0.1").
+			Param("newOrderRespType", "RESULT").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		request := NewOCORequest("LTCBTC", SideBuy, MustDecimal("1"), OrderTypeStopLoss, OrderTypeLimitMaker).
+			ListClientOrderId("h2USkA5YQpaXHPIrkd96xE").
+			AboveClientOrderId("above").
+			AboveStopPrice(MustDecimal("3")).
+			AboveTrailingDelta(100).
+			BelowClientOrderId("below").
+			BelowPrice(MustDecimal("1")).
+			BelowIcebergQuantity(MustDecimal("0.1")).
+			NewOrderResponseType(NewOrderRespTypeResult).
+			RecvWindow(2)
+		response, _ := sdk.NewOCO(request)
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should return error without calling the api when a leg is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		_, err := sdk.NewOCO(NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), "STOP", OrderTypeStopLoss))
+		assert.EqualError(t, err, `invalid aboveType "STOP"`)
+
+		request := NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLossLimit).
+			AbovePrice(MustDecimal("3")).
+			BelowPrice(MustDecimal("1")).
+			BelowStopPrice(MustDecimal("1.5")).
+			BelowTimeInForce("GTD")
+		_, err = sdk.NewOCO(request)
+		assert.EqualError(t, err, `invalid belowTimeInForce "GTD"`)
+
+		_, err = sdk.NewOCO(NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLoss))
+		assert.EqualError(t, err, "abovePrice is required for LIMIT_MAKER orders")
+
+		request = NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLossLimit).
+			AbovePrice(MustDecimal("3")).
+			BelowPrice(MustDecimal("1")).
+			BelowTimeInForce(TimeInForceGTC)
+		_, err = sdk.NewOCO(request)
+		assert.EqualError(t, err, "belowStopPrice or belowTrailingDelta is required for STOP_LOSS_LIMIT orders")
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.NewOCO(validOCORequest())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.NewOCO(validOCORequest())
+
+		assert.Error(t, err)
+	})
+}
+
+func validOCORequest() *newOCORequest {
+	return NewOCORequest("LTCBTC", SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLoss).
+		AbovePrice(MustDecimal("3")).
+		BelowStopPrice(MustDecimal("1.5"))
+}
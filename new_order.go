@@ -1,48 +1,84 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 )
 
+// Response of a new order sent with the ACK response type. It is the fastest response, sent as soon as the order
+// is accepted.
+type OrderAck struct {
+	Symbol          string `json:"symbol"`
+	OrderId         int64  `json:"orderId"`
+	OrderListId     int64  `json:"orderListId"`
+	ClientOrderId   string `json:"clientOrderId"`
+	TransactionTime int64  `json:"transactTime"`
+}
+
+// Response of a new order sent with the RESULT response type. It includes the state of the order after it has been
+// matched. The fields of the optional parameters, like the stop price, are empty when they are not sent.
+type OrderResult struct {
+	OrderAck
+	Price                      Decimal                 `json:"price"`
+	OriginalQuantity           Decimal                 `json:"origQty"`
+	ExecutedQuantity           Decimal                 `json:"executedQty"`
+	OriginalQuoteOrderQuantity Decimal                 `json:"origQuoteOrderQty"`
+	CumulativeQuoteQuantity    Decimal                 `json:"cummulativeQuoteQty"`
+	Status                     OrderStatus             `json:"status"`
+	TimeInForce                TimeInForce             `json:"timeInForce"`
+	Type                       OrderType               `json:"type"`
+	Side                       OrderSide               `json:"side"`
+	WorkingTime                int64                   `json:"workingTime"`
+	SelfTradePreventionMode    SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	StopPrice                  Decimal                 `json:"stopPrice"`
+	IcebergQuantity            Decimal                 `json:"icebergQty"`
+	TrailingDelta              int64                   `json:"trailingDelta"`
+	TrailingTime               int64                   `json:"trailingTime"`
+	StrategyId                 int64                   `json:"strategyId"`
+	StrategyType               int64                   `json:"strategyType"`
+	PegPriceType               PegPriceType            `json:"pegPriceType"`
+	PeggedPrice                Decimal                 `json:"peggedPrice"`
+}
+
+// Response of a new order sent with the FULL response type. It includes the fills of the order.
 type FullOrder struct {
-	Symbol           string      `json:"symbol"`
-	OrderId          int         `json:"orderId"`
-	ClientOrderId    string      `json:"clientOrderId"`
-	TransactionTime  int         `json:"transactTime"`
-	Price            float64     `json:"price,string"`
-	OriginalQuantity float64     `json:"origQty,string"`
-	ExecutedQuantity float64     `json:"executedQty,string"`
-	Status           string      `json:"status"`
-	TimeInForce      string      `json:"timeInForce"`
-	Type             string      `json:"type"`
-	Side             string      `json:"side"`
-	Fills            []OrderFill `json:"fills"`
+	OrderResult
+	Fills []OrderFill `json:"fills"`
 }
 
 type OrderFill struct {
-	Price           float64 `json:"price,string"`
-	Quantity        float64 `json:"qty,string"`
-	Commission      float64 `json:"commission,string"`
+	Price           Decimal `json:"price"`
+	Quantity        Decimal `json:"qty"`
+	Commission      Decimal `json:"commission"`
 	CommissionAsset string  `json:"commissionAsset"`
+	TradeId         int64   `json:"tradeId"`
 }
 
 type newOrderRequest struct {
-	symbol               *string
-	side                 *string
-	orderType            *string
-	quantity             *float64
-	timestamp            *int64
-	timeInForce          *string
-	price                *float64
-	newClientOrderId     *string
-	stopPrice            *float64
-	icebergQuantity      *float64
-	newOrderResponseType *string
-	recvWindow           *int64
-}
-
-func NewOrderRequest(symbol string, side string, orderType string, quantity float64) *newOrderRequest {
-	responseType := "FULL"
+	symbol                  *string
+	side                    *OrderSide
+	orderType               *OrderType
+	quantity                *Decimal
+	quoteOrderQuantity      *Decimal
+	timestamp               *int64
+	timeInForce             *TimeInForce
+	price                   *Decimal
+	newClientOrderId        *string
+	stopPrice               *Decimal
+	trailingDelta           *int64
+	icebergQuantity         *Decimal
+	selfTradePreventionMode *SelfTradePreventionMode
+	strategyId              *int64
+	strategyType            *int64
+	pegPriceType            *PegPriceType
+	newOrderResponseType    *NewOrderResponseType
+	recvWindow              *int64
+}
+
+func NewOrderRequest(symbol string, side OrderSide, orderType OrderType, quantity Decimal) *newOrderRequest {
+	responseType := NewOrderRespTypeFull
 
 	return &newOrderRequest{
 		symbol:               &symbol,
@@ -53,12 +89,26 @@ func NewOrderRequest(symbol string, side string, orderType string, quantity floa
 	}
 }
 
-func (r *newOrderRequest) TimeInForce(value string) *newOrderRequest {
+// MARKET order that spends (BUY) or receives (SELL) the given quantity of the quote asset instead of a quantity of
+// the base asset. For example, buying BTC for 100 USDT.
+func NewQuoteOrderRequest(symbol string, side OrderSide, quoteOrderQuantity Decimal) *newOrderRequest {
+	orderType, responseType := OrderTypeMarket, NewOrderRespTypeFull
+
+	return &newOrderRequest{
+		symbol:               &symbol,
+		side:                 &side,
+		orderType:            &orderType,
+		quoteOrderQuantity:   &quoteOrderQuantity,
+		newOrderResponseType: &responseType,
+	}
+}
+
+func (r *newOrderRequest) TimeInForce(value TimeInForce) *newOrderRequest {
 	r.timeInForce = &value
 	return r
 }
 
-func (r *newOrderRequest) Price(value float64) *newOrderRequest {
+func (r *newOrderRequest) Price(value Decimal) *newOrderRequest {
 	r.price = &value
 	return r
 }
@@ -68,52 +118,206 @@ func (r *newOrderRequest) NewClientOrderId(value string) *newOrderRequest {
 	return r
 }
 
-func (r *newOrderRequest) StopPrice(value float64) *newOrderRequest {
+func (r *newOrderRequest) StopPrice(value Decimal) *newOrderRequest {
 	r.stopPrice = &value
 	return r
 }
 
-func (r *newOrderRequest) IcebergQuantity(value float64) *newOrderRequest {
+// Trailing stop in basis points (BIPS), which can be used instead of or together with the stop price.
+func (r *newOrderRequest) TrailingDelta(value int64) *newOrderRequest {
+	r.trailingDelta = &value
+	return r
+}
+
+func (r *newOrderRequest) IcebergQuantity(value Decimal) *newOrderRequest {
 	r.icebergQuantity = &value
 	return r
 }
 
+func (r *newOrderRequest) SelfTradePreventionMode(value SelfTradePreventionMode) *newOrderRequest {
+	r.selfTradePreventionMode = &value
+	return r
+}
+
+// Arbitrary id to identify the orders of a strategy.
+func (r *newOrderRequest) StrategyId(value int64) *newOrderRequest {
+	r.strategyId = &value
+	return r
+}
+
+// Arbitrary type to identify the orders of a strategy. Values below 1000000 are reserved by the api.
+func (r *newOrderRequest) StrategyType(value int64) *newOrderRequest {
+	r.strategyType = &value
+	return r
+}
+
+// Makes the price of the order follow the order book. The price is optional for pegged orders.
+func (r *newOrderRequest) PegPriceType(value PegPriceType) *newOrderRequest {
+	r.pegPriceType = &value
+	return r
+}
+
 func (r *newOrderRequest) RecvWindow(value int64) *newOrderRequest {
 	r.recvWindow = &value
 	return r
 }
 
-func (r *newOrderRequest) NewOrderResponseType(value string) *newOrderRequest {
+func (r *newOrderRequest) NewOrderResponseType(value NewOrderResponseType) *newOrderRequest {
 	r.newOrderResponseType = &value
 	return r
 }
 
+// Rejects the values of the enums and the combinations of parameters that the api does not accept, so they fail
+// before sending the order.
+func (r *newOrderRequest) validate() error {
+	if err := r.validateEnums(); err != nil {
+		return err
+	}
+
+	orderType := *r.orderType
+	if r.quantity != nil && r.quantity.Sign() <= 0 {
+		return errors.New("quantity must be positive")
+	}
+	if r.quoteOrderQuantity != nil && r.quoteOrderQuantity.Sign() <= 0 {
+		return errors.New("quoteOrderQty must be positive")
+	}
+
+	if orderType.hasPrice() {
+		if r.price == nil && r.pegPriceType == nil {
+			return fmt.Errorf("price is required for %s orders", orderType)
+		}
+	} else if r.price != nil {
+		return fmt.Errorf("price is not accepted by %s orders", orderType)
+	} else if r.pegPriceType != nil {
+		return fmt.Errorf("pegPriceType is not accepted by %s orders", orderType)
+	} else if r.icebergQuantity != nil {
+		return fmt.Errorf("icebergQty is not accepted by %s orders", orderType)
+	}
+
+	if orderType.hasTimeInForce() {
+		if r.timeInForce == nil {
+			return fmt.Errorf("timeInForce is required for %s orders", orderType)
+		}
+	} else if r.timeInForce != nil {
+		return fmt.Errorf("timeInForce is not accepted by %s orders", orderType)
+	}
+
+	if orderType.isStop() {
+		if r.stopPrice == nil && r.trailingDelta == nil {
+			return fmt.Errorf("stopPrice or trailingDelta is required for %s orders", orderType)
+		}
+	} else if r.stopPrice != nil || r.trailingDelta != nil {
+		return fmt.Errorf("stopPrice and trailingDelta are not accepted by %s orders", orderType)
+	}
+
+	if r.icebergQuantity != nil && r.timeInForce != nil && *r.timeInForce != TimeInForceGTC {
+		return errors.New("icebergQty requires GTC time in force")
+	}
+	if r.strategyType != nil && *r.strategyType < 1000000 {
+		return errors.New("strategyType must be at least 1000000")
+	}
+	return nil
+}
+
+func (r *newOrderRequest) validateEnums() error {
+	if !r.side.IsValid() {
+		return fmt.Errorf("invalid order side %q", *r.side)
+	}
+	if !r.orderType.IsValid() {
+		return fmt.Errorf("invalid order type %q", *r.orderType)
+	}
+	if r.timeInForce != nil && !r.timeInForce.IsValid() {
+		return fmt.Errorf("invalid time in force %q", *r.timeInForce)
+	}
+	if r.selfTradePreventionMode != nil && !r.selfTradePreventionMode.IsValid() {
+		return fmt.Errorf("invalid self trade prevention mode %q", *r.selfTradePreventionMode)
+	}
+	if r.pegPriceType != nil && !r.pegPriceType.IsValid() {
+		return fmt.Errorf("invalid peg price type %q", *r.pegPriceType)
+	}
+	if !r.newOrderResponseType.IsValid() {
+		return fmt.Errorf("invalid new order response type %q", *r.newOrderResponseType)
+	}
+	return nil
+}
+
 func (sdk Sdk) NewOrder(request *newOrderRequest) (*FullOrder, error) {
-	req := newRequest("POST", "/api/v3/order").
-		StringParam("symbol", request.symbol).
-		StringParam("type", request.orderType).
-		StringParam("side", request.side).
-		Float64Param("quantity", request.quantity).
-		StringParam("newOrderRespType", request.newOrderResponseType).
-		Int64Param("timestamp", sdk.clock.Now()).
-		Float64Param("price", request.price).
-		Float64Param("icebergQty", request.icebergQuantity).
-		StringParam("newClientOrderId", request.newClientOrderId).
-		Float64Param("stopPrice", request.stopPrice).
-		Int64Param("recvWindow", request.recvWindow).
-		StringParam("timeInForce", request.timeInForce).
-		Sign()
+	return sdk.NewOrderCtx(context.Background(), request)
+}
 
-	responseContent, err := sdk.client.Do(req)
-	if err != nil {
+func (sdk Sdk) NewOrderCtx(ctx context.Context, request *newOrderRequest) (*FullOrder, error) {
+	response := &FullOrder{}
+	if err := sdk.newOrder(ctx, request, response); err != nil {
 		return nil, err
 	}
+	return response, nil
+}
 
-	return parseNewOrderResponse(responseContent)
+// Sends a new order with the ACK response type, whatever the response type of the request is.
+func (sdk Sdk) NewOrderAck(request *newOrderRequest) (*OrderAck, error) {
+	return sdk.NewOrderAckCtx(context.Background(), request)
 }
 
-func parseNewOrderResponse(jsonContent []byte) (*FullOrder, error) {
-	response := &FullOrder{}
-	err := json.Unmarshal(jsonContent, &response)
-	return response, err
+func (sdk Sdk) NewOrderAckCtx(ctx context.Context, request *newOrderRequest) (*OrderAck, error) {
+	response := &OrderAck{}
+	if err := sdk.newOrder(ctx, request.withResponseType(NewOrderRespTypeAck), response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Sends a new order with the RESULT response type, whatever the response type of the request is.
+func (sdk Sdk) NewOrderResult(request *newOrderRequest) (*OrderResult, error) {
+	return sdk.NewOrderResultCtx(context.Background(), request)
+}
+
+func (sdk Sdk) NewOrderResultCtx(ctx context.Context, request *newOrderRequest) (*OrderResult, error) {
+	response := &OrderResult{}
+	if err := sdk.newOrder(ctx, request.withResponseType(NewOrderRespTypeResult), response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Copy of the request with another response type, so the request of the caller is not modified.
+func (r *newOrderRequest) withResponseType(value NewOrderResponseType) *newOrderRequest {
+	request := *r
+	request.newOrderResponseType = &value
+	return &request
+}
+
+func (sdk Sdk) newOrder(ctx context.Context, request *newOrderRequest, response interface{}) error {
+	if err := request.validate(); err != nil {
+		return err
+	}
+
+	responseContent, err := sdk.client.Do(ctx, sdk.buildNewOrderRequest("/api/v3/order", request))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseContent, response)
+}
+
+func (sdk Sdk) buildNewOrderRequest(path string, request *newOrderRequest) *request {
+	return newRequest("POST", path).
+		StringParam("symbol", request.symbol).
+		StringParam("side", (*string)(request.side)).
+		StringParam("type", (*string)(request.orderType)).
+		DecimalParam("quantity", request.quantity).
+		DecimalParam("quoteOrderQty", request.quoteOrderQuantity).
+		StringParam("timeInForce", (*string)(request.timeInForce)).
+		DecimalParam("price", request.price).
+		StringParam("newClientOrderId", request.newClientOrderId).
+		DecimalParam("stopPrice", request.stopPrice).
+		Int64Param("trailingDelta", request.trailingDelta).
+		DecimalParam("icebergQty", request.icebergQuantity).
+		StringParam("selfTradePreventionMode", (*string)(request.selfTradePreventionMode)).
+		Int64Param("strategyId", request.strategyId).
+		Int64Param("strategyType", request.strategyType).
+		StringParam("pegPriceType", (*string)(request.pegPriceType)).
+		StringParam("newOrderRespType", (*string)(request.newOrderResponseType)).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
 }
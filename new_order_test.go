@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -25,18 +26,18 @@ func TestSdk_NewOrder(t *testing.T) {
 			Param("symbol", "BTCUSDT").
 			Param("side", "SELL").
 			Param("type", "MARKET").
-			Param("quantity", "10.00000000").
+			Param("quantity", "10").
 			Param("newOrderRespType", "FULL").
 			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
 			Sign()
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validFullOrderJson(), nil)
 
-		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", 10)
+		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", MustDecimal("10"))
 		response, _ := sdk.NewOrder(request)
 
 		assert.Equal(t, validFullOrderResponse(), response)
@@ -54,13 +55,18 @@ func TestSdk_NewOrder(t *testing.T) {
 		expectedRequest := newRequest(method, url).
 			Param("symbol", "BTCUSDT").
 			Param("side", "SELL").
-			Param("type", "MARKET").
-			Param("quantity", "10.00000000").
+			Param("type", "STOP_LOSS_LIMIT").
+			Param("quantity", "10").
 			Param("timeInForce", "GTC").
-			Param("price", "0.10000000").
+			Param("price", "0.1").
 			Param("newClientOrderId", "6gCrw2kRUAF9CvJDGP16IP").
-			Param("stopPrice", "0.10000000").
-			Param("icebergQty", "0.10000000").
+			Param("stopPrice", "0.1").
+			Param("trailingDelta", "100").
+			Param("icebergQty", "0.1").
+			Param("selfTradePreventionMode", "EXPIRE_TAKER").
+			Param("strategyId", "1").
+			Param("strategyType", "1000000").
+			Param("pegPriceType", "PRIMARY_PEG").
 			Param("newOrderRespType", "ACK").
 			Param("recvWindow", "2").
 			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
@@ -68,16 +74,21 @@ func TestSdk_NewOrder(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validFullOrderJson(), nil)
 
-		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", 10).
+		request := NewOrderRequest("BTCUSDT", "SELL", "STOP_LOSS_LIMIT", MustDecimal("10")).
 			TimeInForce("GTC").
-			Price(0.1).
+			Price(MustDecimal("0.1")).
 			NewClientOrderId("6gCrw2kRUAF9CvJDGP16IP").
-			StopPrice(0.1).
-			IcebergQuantity(0.1).
+			StopPrice(MustDecimal("0.1")).
+			TrailingDelta(100).
+			IcebergQuantity(MustDecimal("0.1")).
+			SelfTradePreventionMode(SelfTradePreventionModeExpireTaker).
+			StrategyId(1).
+			StrategyType(1000000).
+			PegPriceType(PegPriceTypePrimaryPeg).
 			NewOrderResponseType("ACK").
 			RecvWindow(2)
 
@@ -86,6 +97,114 @@ func TestSdk_NewOrder(t *testing.T) {
 		assert.Equal(t, validFullOrderResponse(), response)
 	})
 
+	t.Run("It should send the quote order quantity of market orders", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "BUY").
+			Param("type", "MARKET").
+			Param("quoteOrderQty", "100").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validFullOrderJson(), nil)
+
+		response, _ := sdk.NewOrder(NewQuoteOrderRequest("BTCUSDT", SideBuy, MustDecimal("100")))
+
+		assert.Equal(t, validFullOrderResponse(), response)
+	})
+
+	t.Run("It should return error without calling the api when a combination of parameters is not accepted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		tests := []struct {
+			request *newOrderRequest
+			err     string
+		}{
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("0")), "quantity must be positive"},
+			{NewQuoteOrderRequest("BTCUSDT", SideBuy, MustDecimal("-1")), "quoteOrderQty must be positive"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("1")).TimeInForce(TimeInForceGTC), "price is required for LIMIT orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).Price(MustDecimal("1")), "price is not accepted by MARKET orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).PegPriceType(PegPriceTypeMarketPeg), "pegPriceType is not accepted by MARKET orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).IcebergQuantity(MustDecimal("1")), "icebergQty is not accepted by MARKET orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("1")).Price(MustDecimal("1")), "timeInForce is required for LIMIT orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimitMaker, MustDecimal("1")).Price(MustDecimal("1")).TimeInForce(TimeInForceGTC), "timeInForce is not accepted by LIMIT_MAKER orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeStopLoss, MustDecimal("1")), "stopPrice or trailingDelta is required for STOP_LOSS orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).TrailingDelta(100), "stopPrice and trailingDelta are not accepted by MARKET orders"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("1")).Price(MustDecimal("1")).TimeInForce(TimeInForceIOC).IcebergQuantity(MustDecimal("0.1")), "icebergQty requires GTC time in force"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).StrategyType(999999), "strategyType must be at least 1000000"},
+			{NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("1")).SelfTradePreventionMode("EXPIRE"), `invalid self trade prevention mode "EXPIRE"`},
+		}
+
+		for _, test := range tests {
+			_, err := sdk.NewOrder(test.request)
+			assert.EqualError(t, err, test.err)
+		}
+	})
+
+	t.Run("It should accept pegged orders without price", func(t *testing.T) {
+		request := NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimitMaker, MustDecimal("1")).PegPriceType(PegPriceTypePrimaryPeg)
+
+		assert.NoError(t, request.validate())
+	})
+
+	t.Run("It should pass the context to the client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "SELL").
+			Param("type", "MARKET").
+			Param("quantity", "10").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		mockedClient.
+			EXPECT().
+			Do(ctx, expectedRequest).
+			MinTimes(1).
+			Return(validFullOrderJson(), nil)
+
+		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", MustDecimal("10"))
+		response, _ := sdk.NewOrderCtx(ctx, request)
+
+		assert.Equal(t, validFullOrderResponse(), response)
+	})
+
+	t.Run("It should return error without calling the api when an enum is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		_, err := sdk.NewOrder(NewOrderRequest("BTCUSDT", "SEL", OrderTypeMarket, MustDecimal("10")))
+		assert.EqualError(t, err, `invalid order side "SEL"`)
+
+		_, err = sdk.NewOrder(NewOrderRequest("BTCUSDT", SideSell, OrderTypeLimit, MustDecimal("10")).TimeInForce("GTD"))
+		assert.EqualError(t, err, `invalid time in force "GTD"`)
+	})
+
 	t.Run("It should return error when api fails", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mockedClock := NewMockClock(ctrl)
@@ -99,18 +218,18 @@ func TestSdk_NewOrder(t *testing.T) {
 			Param("symbol", "BTCUSDT").
 			Param("side", "SELL").
 			Param("type", "MARKET").
-			Param("quantity", "10.00000000").
+			Param("quantity", "10").
 			Param("newOrderRespType", "FULL").
 			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
 			Sign()
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
-		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", 10)
+		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", MustDecimal("10"))
 		_, err := sdk.NewOrder(request)
 
 		assert.Error(t, err)
@@ -129,43 +248,158 @@ func TestSdk_NewOrder(t *testing.T) {
 			Param("symbol", "BTCUSDT").
 			Param("side", "SELL").
 			Param("type", "MARKET").
-			Param("quantity", "10.00000000").
+			Param("quantity", "10").
 			Param("newOrderRespType", "FULL").
 			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
 			Sign()
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
-		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", 10)
+		request := NewOrderRequest("BTCUSDT", "SELL", "MARKET", MustDecimal("10"))
 		_, err := sdk.NewOrder(request)
 
 		assert.Error(t, err)
 	})
 }
 
+func TestSdk_NewOrderAck(t *testing.T) {
+	method, url := "POST", "/api/v3/order"
+
+	t.Run("It should send the ACK response type and convert api response to an OrderAck", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "SELL").
+			Param("type", "MARKET").
+			Param("quantity", "10").
+			Param("newOrderRespType", "ACK").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderAckJson(), nil)
+
+		request := NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10"))
+		response, _ := sdk.NewOrderAck(request)
+
+		assert.Equal(t, validOrderAckResponse(), response)
+		assert.Equal(t, NewOrderRespTypeFull, *request.newOrderResponseType)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.NewOrderAck(NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10")))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSdk_NewOrderResult(t *testing.T) {
+	method, url := "POST", "/api/v3/order"
+
+	t.Run("It should send the RESULT response type and convert api response to an OrderResult", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "SELL").
+			Param("type", "MARKET").
+			Param("quantity", "10").
+			Param("newOrderRespType", "RESULT").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderResultJson(), nil)
+
+		request := NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10"))
+		response, _ := sdk.NewOrderResult(request)
+
+		assert.Equal(t, validOrderResultResponse(), response)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.NewOrderResult(NewOrderRequest("BTCUSDT", SideSell, OrderTypeMarket, MustDecimal("10")))
+
+		assert.Error(t, err)
+	})
+}
+
 func validFullOrderJson() []byte {
 	return []byte(`{
   		"symbol": "BTCUSDT",
   		"orderId": 28,
+  		"orderListId": -1,
   		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
   		"transactTime": 1507725176595,
   		"price": "0.1",
   		"origQty": "10.00000000",
   		"executedQty": "10.00000000",
+  		"origQuoteOrderQty": "0.00000000",
+  		"cummulativeQuoteQty": "40000.00000000",
   		"status": "FILLED",
   		"timeInForce": "GTC",
   		"type": "MARKET",
   		"side": "SELL",
+  		"workingTime": 1507725176595,
+  		"selfTradePreventionMode": "NONE",
   		"fills": [
     		{
       			"price": "4000.00000000",
       			"qty": "10.00000000",
       			"commission": "4.00000000",
-      			"commissionAsset": "USDT"
+      			"commissionAsset": "USDT",
+      			"tradeId": 56
     		}
   		]
 	}`)
@@ -173,22 +407,70 @@ func validFullOrderJson() []byte {
 
 func validFullOrderResponse() *FullOrder {
 	return &FullOrder{
-		Symbol:           "BTCUSDT",
-		OrderId:          28,
-		ClientOrderId:    "6gCrw2kRUAF9CvJDGP16IP",
-		TransactionTime:  1507725176595,
-		Price:            0.1,
-		OriginalQuantity: 10,
-		ExecutedQuantity: 10,
-		Status:           "FILLED",
-		TimeInForce:      "GTC",
-		Type:             "MARKET",
-		Side:             "SELL",
+		OrderResult: *validOrderResultResponse(),
 		Fills: []OrderFill{{
-			Price:           4000,
-			Quantity:        10,
-			Commission:      4,
+			Price:           MustDecimal("4000"),
+			Quantity:        MustDecimal("10"),
+			Commission:      MustDecimal("4"),
 			CommissionAsset: "USDT",
+			TradeId:         56,
 		}},
 	}
 }
+
+func validOrderAckJson() []byte {
+	return []byte(`{
+  		"symbol": "BTCUSDT",
+  		"orderId": 28,
+  		"orderListId": -1,
+  		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+  		"transactTime": 1507725176595
+	}`)
+}
+
+func validOrderAckResponse() *OrderAck {
+	return &OrderAck{
+		Symbol:          "BTCUSDT",
+		OrderId:         28,
+		OrderListId:     -1,
+		ClientOrderId:   "6gCrw2kRUAF9CvJDGP16IP",
+		TransactionTime: 1507725176595,
+	}
+}
+
+func validOrderResultJson() []byte {
+	return []byte(`{
+  		"symbol": "BTCUSDT",
+  		"orderId": 28,
+  		"orderListId": -1,
+  		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+  		"transactTime": 1507725176595,
+  		"price": "0.1",
+  		"origQty": "10.00000000",
+  		"executedQty": "10.00000000",
+  		"origQuoteOrderQty": "0.00000000",
+  		"cummulativeQuoteQty": "40000.00000000",
+  		"status": "FILLED",
+  		"timeInForce": "GTC",
+  		"type": "MARKET",
+  		"side": "SELL",
+  		"workingTime": 1507725176595,
+  		"selfTradePreventionMode": "NONE"
+	}`)
+}
+
+func validOrderResultResponse() *OrderResult {
+	return &OrderResult{
+		OrderAck:                *validOrderAckResponse(),
+		Price:                   MustDecimal("0.1"),
+		OriginalQuantity:        MustDecimal("10"),
+		ExecutedQuantity:        MustDecimal("10"),
+		CumulativeQuoteQuantity: MustDecimal("40000"),
+		Status:                  "FILLED",
+		TimeInForce:             "GTC",
+		Type:                    "MARKET",
+		Side:                    "SELL",
+		WorkingTime:             1507725176595,
+		SelfTradePreventionMode: "NONE",
+	}
+}
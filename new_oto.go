@@ -0,0 +1,131 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+)
+
+type newOTORequest struct {
+	symbol               *string
+	working              orderListLeg
+	pending              orderListLeg
+	listClientOrderId    *string
+	newOrderResponseType *NewOrderResponseType
+	recvWindow           *int64
+	timestamp            *int64
+}
+
+// One-triggers-the-other order: a LIMIT or LIMIT_MAKER working order that places the pending order when it is
+// filled. For example, an entry order that places its take profit.
+func NewOTORequest(symbol string, workingSide OrderSide, workingType OrderType, workingQuantity Decimal, pendingSide OrderSide, pendingType OrderType, pendingQuantity Decimal) *newOTORequest {
+	return &newOTORequest{
+		symbol:  &symbol,
+		working: orderListLeg{side: &workingSide, orderType: &workingType, quantity: &workingQuantity},
+		pending: orderListLeg{side: &pendingSide, orderType: &pendingType, quantity: &pendingQuantity},
+	}
+}
+
+func (r *newOTORequest) ListClientOrderId(value string) *newOTORequest {
+	r.listClientOrderId = &value
+	return r
+}
+
+func (r *newOTORequest) WorkingClientOrderId(value string) *newOTORequest {
+	r.working.clientOrderId = &value
+	return r
+}
+
+func (r *newOTORequest) WorkingPrice(value Decimal) *newOTORequest {
+	r.working.price = &value
+	return r
+}
+
+func (r *newOTORequest) WorkingIcebergQuantity(value Decimal) *newOTORequest {
+	r.working.icebergQuantity = &value
+	return r
+}
+
+func (r *newOTORequest) WorkingTimeInForce(value TimeInForce) *newOTORequest {
+	r.working.timeInForce = &value
+	return r
+}
+
+func (r *newOTORequest) PendingClientOrderId(value string) *newOTORequest {
+	r.pending.clientOrderId = &value
+	return r
+}
+
+func (r *newOTORequest) PendingPrice(value Decimal) *newOTORequest {
+	r.pending.price = &value
+	return r
+}
+
+func (r *newOTORequest) PendingStopPrice(value Decimal) *newOTORequest {
+	r.pending.stopPrice = &value
+	return r
+}
+
+func (r *newOTORequest) PendingTrailingDelta(value int64) *newOTORequest {
+	r.pending.trailingDelta = &value
+	return r
+}
+
+func (r *newOTORequest) PendingIcebergQuantity(value Decimal) *newOTORequest {
+	r.pending.icebergQuantity = &value
+	return r
+}
+
+func (r *newOTORequest) PendingTimeInForce(value TimeInForce) *newOTORequest {
+	r.pending.timeInForce = &value
+	return r
+}
+
+func (r *newOTORequest) NewOrderResponseType(value NewOrderResponseType) *newOTORequest {
+	r.newOrderResponseType = &value
+	return r
+}
+
+func (r *newOTORequest) RecvWindow(value int64) *newOTORequest {
+	r.recvWindow = &value
+	return r
+}
+
+func (r *newOTORequest) validate() error {
+	if err := r.working.validateWorking(); err != nil {
+		return err
+	}
+	if err := r.pending.validate("pending"); err != nil {
+		return err
+	}
+	if r.newOrderResponseType != nil && !r.newOrderResponseType.IsValid() {
+		return fmt.Errorf("invalid new order response type %q", *r.newOrderResponseType)
+	}
+	return nil
+}
+
+func (sdk Sdk) NewOTO(request *newOTORequest) (*OrderListReport, error) {
+	return sdk.NewOTOCtx(context.Background(), request)
+}
+
+func (sdk Sdk) NewOTOCtx(ctx context.Context, request *newOTORequest) (*OrderListReport, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	req := newRequest("POST", "/api/v3/orderList/oto").
+		StringParam("symbol", request.symbol).
+		StringParam("listClientOrderId", request.listClientOrderId)
+	req = request.working.addParams("working", req)
+	req = request.pending.addParams("pending", req).
+		StringParam("newOrderRespType", (*string)(request.newOrderResponseType)).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListReportResponse(responseContent)
+}
@@ -0,0 +1,180 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_NewOTO(t *testing.T) {
+	method, url := "POST", "/api/v3/orderList/oto"
+
+	t.Run("It should convert api response to an OrderListReport", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("workingSide", "BUY").
+			Param("workingType", "LIMIT").
+			Param("workingQuantity", "1").
+			Param("workingPrice", "2").
+			Param("workingTimeInForce", "GTC").
+			Param("pendingSide", "SELL").
+			Param("pendingType", "LIMIT_MAKER").
+			Param("pendingQuantity", "1").
+			Param("pendingPrice", "3").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		response, _ := sdk.NewOTO(validOTORequest())
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("listClientOrderId", "list").
+			Param("workingSide", "BUY").
+			Param("workingType", "LIMIT").
+			Param("workingQuantity", "1").
+			Param("workingClientOrderId", "working").
+			Param("workingPrice", "2").
+			Param("workingIcebergQty", "0.1").
+			Param("workingTimeInForce", "GTC").
+			Param("pendingSide", "SELL").
+			Param("pendingType", "STOP_LOSS_LIMIT").
+			Param("pendingQuantity", "1").
+			Param("pendingClientOrderId", "pending").
+			Param("pendingPrice", "1").
+			Param("pendingStopPrice", "1.5").
+			Param("pendingTrailingDelta", "100").
+			Param("pendingIcebergQty", "0.1").
+			Param("pendingTimeInForce", "GTC").
+			Param("newOrderRespType", "FULL").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		request := NewOTORequest("LTCBTC", SideBuy, OrderTypeLimit, MustDecimal("1"), SideSell, OrderTypeStopLossLimit, MustDecimal("1")).
+			ListClientOrderId("list").
+			WorkingClientOrderId("working").
+			WorkingPrice(MustDecimal("2")).
+			WorkingIcebergQuantity(MustDecimal("0.1")).
+			WorkingTimeInForce(TimeInForceGTC).
+			PendingClientOrderId("pending").
+			PendingPrice(MustDecimal("1")).
+			PendingStopPrice(MustDecimal("1.5")).
+			PendingTrailingDelta(100).
+			PendingIcebergQuantity(MustDecimal("0.1")).
+			PendingTimeInForce(TimeInForceGTC).
+			NewOrderResponseType(NewOrderRespTypeFull).
+			RecvWindow(2)
+		response, _ := sdk.NewOTO(request)
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should return error without calling the api when a leg is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		_, err := sdk.NewOTO(NewOTORequest("LTCBTC", SideBuy, OrderTypeMarket, MustDecimal("1"), SideSell, OrderTypeLimitMaker, MustDecimal("1")))
+		assert.EqualError(t, err, `workingType must be LIMIT or LIMIT_MAKER, got "MARKET"`)
+
+		_, err = sdk.NewOTO(NewOTORequest("LTCBTC", SideBuy, OrderTypeLimit, MustDecimal("1"), SideSell, OrderTypeLimitMaker, MustDecimal("1")))
+		assert.EqualError(t, err, "workingPrice is required for LIMIT orders")
+
+		request := NewOTORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, OrderTypeLimitMaker, MustDecimal("0")).
+			WorkingPrice(MustDecimal("2")).
+			PendingPrice(MustDecimal("3"))
+		_, err = sdk.NewOTO(request)
+		assert.EqualError(t, err, "pendingQuantity must be positive")
+
+		request = NewOTORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), "SEL", OrderTypeLimitMaker, MustDecimal("1")).
+			WorkingPrice(MustDecimal("2"))
+		_, err = sdk.NewOTO(request)
+		assert.EqualError(t, err, `invalid pendingSide "SEL"`)
+
+		request = NewOTORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, OrderTypeStopLoss, MustDecimal("1")).
+			WorkingPrice(MustDecimal("2"))
+		_, err = sdk.NewOTO(request)
+		assert.EqualError(t, err, "pendingStopPrice or pendingTrailingDelta is required for STOP_LOSS orders")
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.NewOTO(validOTORequest())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.NewOTO(validOTORequest())
+
+		assert.Error(t, err)
+	})
+}
+
+func validOTORequest() *newOTORequest {
+	return NewOTORequest("LTCBTC", SideBuy, OrderTypeLimit, MustDecimal("1"), SideSell, OrderTypeLimitMaker, MustDecimal("1")).
+		WorkingPrice(MustDecimal("2")).
+		WorkingTimeInForce(TimeInForceGTC).
+		PendingPrice(MustDecimal("3"))
+}
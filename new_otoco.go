@@ -0,0 +1,180 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type newOTOCORequest struct {
+	symbol               *string
+	working              orderListLeg
+	pendingSide          *OrderSide
+	pendingQuantity      *Decimal
+	pendingAbove         orderListLeg
+	pendingBelow         orderListLeg
+	listClientOrderId    *string
+	newOrderResponseType *NewOrderResponseType
+	recvWindow           *int64
+	timestamp            *int64
+}
+
+// One-triggers-a-one-cancels-the-other order: a LIMIT or LIMIT_MAKER working order that places an OCO when it is
+// filled. For example, an entry order that places its take profit and its stop loss.
+func NewOTOCORequest(symbol string, workingSide OrderSide, workingType OrderType, workingQuantity Decimal, pendingSide OrderSide, pendingQuantity Decimal, pendingAboveType OrderType, pendingBelowType OrderType) *newOTOCORequest {
+	return &newOTOCORequest{
+		symbol:          &symbol,
+		working:         orderListLeg{side: &workingSide, orderType: &workingType, quantity: &workingQuantity},
+		pendingSide:     &pendingSide,
+		pendingQuantity: &pendingQuantity,
+		pendingAbove:    orderListLeg{orderType: &pendingAboveType},
+		pendingBelow:    orderListLeg{orderType: &pendingBelowType},
+	}
+}
+
+func (r *newOTOCORequest) ListClientOrderId(value string) *newOTOCORequest {
+	r.listClientOrderId = &value
+	return r
+}
+
+func (r *newOTOCORequest) WorkingClientOrderId(value string) *newOTOCORequest {
+	r.working.clientOrderId = &value
+	return r
+}
+
+func (r *newOTOCORequest) WorkingPrice(value Decimal) *newOTOCORequest {
+	r.working.price = &value
+	return r
+}
+
+func (r *newOTOCORequest) WorkingIcebergQuantity(value Decimal) *newOTOCORequest {
+	r.working.icebergQuantity = &value
+	return r
+}
+
+func (r *newOTOCORequest) WorkingTimeInForce(value TimeInForce) *newOTOCORequest {
+	r.working.timeInForce = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAboveClientOrderId(value string) *newOTOCORequest {
+	r.pendingAbove.clientOrderId = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAbovePrice(value Decimal) *newOTOCORequest {
+	r.pendingAbove.price = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAboveStopPrice(value Decimal) *newOTOCORequest {
+	r.pendingAbove.stopPrice = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAboveTrailingDelta(value int64) *newOTOCORequest {
+	r.pendingAbove.trailingDelta = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAboveIcebergQuantity(value Decimal) *newOTOCORequest {
+	r.pendingAbove.icebergQuantity = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingAboveTimeInForce(value TimeInForce) *newOTOCORequest {
+	r.pendingAbove.timeInForce = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowClientOrderId(value string) *newOTOCORequest {
+	r.pendingBelow.clientOrderId = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowPrice(value Decimal) *newOTOCORequest {
+	r.pendingBelow.price = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowStopPrice(value Decimal) *newOTOCORequest {
+	r.pendingBelow.stopPrice = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowTrailingDelta(value int64) *newOTOCORequest {
+	r.pendingBelow.trailingDelta = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowIcebergQuantity(value Decimal) *newOTOCORequest {
+	r.pendingBelow.icebergQuantity = &value
+	return r
+}
+
+func (r *newOTOCORequest) PendingBelowTimeInForce(value TimeInForce) *newOTOCORequest {
+	r.pendingBelow.timeInForce = &value
+	return r
+}
+
+func (r *newOTOCORequest) NewOrderResponseType(value NewOrderResponseType) *newOTOCORequest {
+	r.newOrderResponseType = &value
+	return r
+}
+
+func (r *newOTOCORequest) RecvWindow(value int64) *newOTOCORequest {
+	r.recvWindow = &value
+	return r
+}
+
+func (r *newOTOCORequest) validate() error {
+	if err := r.working.validateWorking(); err != nil {
+		return err
+	}
+	if !r.pendingSide.IsValid() {
+		return fmt.Errorf("invalid pendingSide %q", *r.pendingSide)
+	}
+	if r.pendingQuantity.Sign() <= 0 {
+		return errors.New("pendingQuantity must be positive")
+	}
+	if err := r.pendingAbove.validate("pendingAbove"); err != nil {
+		return err
+	}
+	if err := r.pendingBelow.validate("pendingBelow"); err != nil {
+		return err
+	}
+	if r.newOrderResponseType != nil && !r.newOrderResponseType.IsValid() {
+		return fmt.Errorf("invalid new order response type %q", *r.newOrderResponseType)
+	}
+	return nil
+}
+
+func (sdk Sdk) NewOTOCO(request *newOTOCORequest) (*OrderListReport, error) {
+	return sdk.NewOTOCOCtx(context.Background(), request)
+}
+
+func (sdk Sdk) NewOTOCOCtx(ctx context.Context, request *newOTOCORequest) (*OrderListReport, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	req := newRequest("POST", "/api/v3/orderList/otoco").
+		StringParam("symbol", request.symbol).
+		StringParam("listClientOrderId", request.listClientOrderId)
+	req = request.working.addParams("working", req).
+		StringParam("pendingSide", (*string)(request.pendingSide)).
+		DecimalParam("pendingQuantity", request.pendingQuantity)
+	req = request.pendingAbove.addParams("pendingAbove", req)
+	req = request.pendingBelow.addParams("pendingBelow", req).
+		StringParam("newOrderRespType", (*string)(request.newOrderResponseType)).
+		Int64Param("recvWindow", request.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListReportResponse(responseContent)
+}
@@ -0,0 +1,189 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_NewOTOCO(t *testing.T) {
+	method, url := "POST", "/api/v3/orderList/otoco"
+
+	t.Run("It should convert api response to an OrderListReport", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("workingSide", "BUY").
+			Param("workingType", "LIMIT_MAKER").
+			Param("workingQuantity", "1").
+			Param("workingPrice", "2").
+			Param("pendingSide", "SELL").
+			Param("pendingQuantity", "1").
+			Param("pendingAboveType", "LIMIT_MAKER").
+			Param("pendingAbovePrice", "3").
+			Param("pendingBelowType", "STOP_LOSS").
+			Param("pendingBelowStopPrice", "1.5").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		response, _ := sdk.NewOTOCO(validOTOCORequest())
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "LTCBTC").
+			Param("listClientOrderId", "list").
+			Param("workingSide", "BUY").
+			Param("workingType", "LIMIT").
+			Param("workingQuantity", "1").
+			Param("workingClientOrderId", "working").
+			Param("workingPrice", "2").
+			Param("workingIcebergQty", "0.1").
+			Param("workingTimeInForce", "GTC").
+			Param("pendingSide", "SELL").
+			Param("pendingQuantity", "1").
+			Param("pendingAboveType", "TAKE_PROFIT_LIMIT").
+			Param("pendingAboveClientOrderId", "above").
+			Param("pendingAbovePrice", "3").
+			Param("pendingAboveStopPrice", "2.9").
+			Param("pendingAboveIcebergQty", "0.1").
+			Param("pendingAboveTimeInForce", "GTC").
+			Param("pendingBelowType", "STOP_LOSS_LIMIT").
+			Param("pendingBelowClientOrderId", "below").
+			Param("pendingBelowPrice", "1").
+			Param("pendingBelowTrailingDelta", "100").
+			Param("pendingBelowIcebergQty", "0.1").
+			Param("pendingBelowTimeInForce", "GTC").
+			Param("newOrderRespType", "RESULT").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderListReportJson(), nil)
+
+		request := NewOTOCORequest("LTCBTC", SideBuy, OrderTypeLimit, MustDecimal("1"), SideSell, MustDecimal("1"), OrderTypeTakeProfitLimit, OrderTypeStopLossLimit).
+			ListClientOrderId("list").
+			WorkingClientOrderId("working").
+			WorkingPrice(MustDecimal("2")).
+			WorkingIcebergQuantity(MustDecimal("0.1")).
+			WorkingTimeInForce(TimeInForceGTC).
+			PendingAboveClientOrderId("above").
+			PendingAbovePrice(MustDecimal("3")).
+			PendingAboveStopPrice(MustDecimal("2.9")).
+			PendingAboveIcebergQuantity(MustDecimal("0.1")).
+			PendingAboveTimeInForce(TimeInForceGTC).
+			PendingBelowClientOrderId("below").
+			PendingBelowPrice(MustDecimal("1")).
+			PendingBelowTrailingDelta(100).
+			PendingBelowIcebergQuantity(MustDecimal("0.1")).
+			PendingBelowTimeInForce(TimeInForceGTC).
+			NewOrderResponseType(NewOrderRespTypeResult).
+			RecvWindow(2)
+		response, _ := sdk.NewOTOCO(request)
+
+		assert.Equal(t, validOrderListReportResponse(), response)
+	})
+
+	t.Run("It should return error without calling the api when a leg is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		_, err := sdk.NewOTOCO(NewOTOCORequest("LTCBTC", SideBuy, OrderTypeStopLoss, MustDecimal("1"), SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLoss))
+		assert.EqualError(t, err, `workingType must be LIMIT or LIMIT_MAKER, got "STOP_LOSS"`)
+
+		request := NewOTOCORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, MustDecimal("-1"), OrderTypeLimitMaker, OrderTypeStopLoss).
+			WorkingPrice(MustDecimal("2"))
+		_, err = sdk.NewOTOCO(request)
+		assert.EqualError(t, err, "pendingQuantity must be positive")
+
+		request = NewOTOCORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLoss).
+			WorkingPrice(MustDecimal("2"))
+		_, err = sdk.NewOTOCO(request)
+		assert.EqualError(t, err, "pendingAbovePrice is required for LIMIT_MAKER orders")
+
+		request = NewOTOCORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLossLimit).
+			WorkingPrice(MustDecimal("2")).
+			PendingAbovePrice(MustDecimal("3")).
+			PendingBelowPrice(MustDecimal("1")).
+			PendingBelowStopPrice(MustDecimal("1.5"))
+		_, err = sdk.NewOTOCO(request)
+		assert.EqualError(t, err, "pendingBelowTimeInForce is required for STOP_LOSS_LIMIT orders")
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.NewOTOCO(validOTOCORequest())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.NewOTOCO(validOTOCORequest())
+
+		assert.Error(t, err)
+	})
+}
+
+func validOTOCORequest() *newOTOCORequest {
+	return NewOTOCORequest("LTCBTC", SideBuy, OrderTypeLimitMaker, MustDecimal("1"), SideSell, MustDecimal("1"), OrderTypeLimitMaker, OrderTypeStopLoss).
+		WorkingPrice(MustDecimal("2")).
+		PendingAbovePrice(MustDecimal("3")).
+		PendingBelowStopPrice(MustDecimal("1.5"))
+}
@@ -0,0 +1,35 @@
+package binance
+
+import "context"
+
+type getOpenOrderListsQuery struct {
+	recvWindow *int64
+	timestamp  *int64
+}
+
+func NewGetOpenOrderListsQuery() *getOpenOrderListsQuery {
+	return &getOpenOrderListsQuery{}
+}
+
+func (r *getOpenOrderListsQuery) RecvWindow(value int64) *getOpenOrderListsQuery {
+	r.recvWindow = &value
+	return r
+}
+
+func (sdk Sdk) GetOpenOrderLists(query *getOpenOrderListsQuery) ([]OrderList, error) {
+	return sdk.GetOpenOrderListsCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetOpenOrderListsCtx(ctx context.Context, query *getOpenOrderListsQuery) ([]OrderList, error) {
+	req := newRequest("GET", "/api/v3/openOrderList").
+		Int64Param("recvWindow", query.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderListsResponse(responseContent)
+}
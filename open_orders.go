@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -22,13 +23,17 @@ func (r *getOpenOrdersQuery) RecvWindow(value int64) *getOpenOrdersQuery {
 }
 
 func (sdk Sdk) GetOpenOrders(query *getOpenOrdersQuery) ([]Order, error) {
+	return sdk.GetOpenOrdersCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetOpenOrdersCtx(ctx context.Context, query *getOpenOrdersQuery) ([]Order, error) {
 	req := newRequest("GET", "/api/v3/openOrders").
 		StringParam("symbol", query.symbol).
 		Int64Param("recvWindow", query.recvWindow).
 		Int64Param("timestamp", sdk.clock.Now()).
 		Sign()
 
-	responseContent, err := sdk.client.Do(req)
+	responseContent, err := sdk.client.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
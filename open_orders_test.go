@@ -28,7 +28,7 @@ func TestSdk_GetOpenOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validOpenOrdersJson(), nil)
 
@@ -55,7 +55,7 @@ func TestSdk_GetOpenOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validOpenOrdersJson(), nil)
 
@@ -81,7 +81,7 @@ func TestSdk_GetOpenOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -107,7 +107,7 @@ func TestSdk_GetOpenOrders(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -142,15 +142,15 @@ func validOpenOrdersResponse() []Order {
 		Symbol:           "LTCBTC",
 		OrderId:          1,
 		ClientOrderId:    "myOrder1",
-		Price:            0.1,
-		OriginalQuantity: 1,
-		ExecutedQuantity: 0,
+		Price:            MustDecimal("0.1"),
+		OriginalQuantity: MustDecimal("1"),
+		ExecutedQuantity: MustDecimal("0"),
 		Status:           "NEW",
 		TimeInForce:      "GTC",
 		Type:             "LIMIT",
 		Side:             "BUY",
-		StopPrice:        0.0,
-		IcebergQuantity:  0.0,
+		StopPrice:        MustDecimal("0.0"),
+		IcebergQuantity:  MustDecimal("0.0"),
 		Time:             1499827319559,
 		IsWorking:        true,
 	}}
@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type options struct {
+	environment Environment
+	httpClient  *http.Client
+	timeout     time.Duration
+	proxy       *url.URL
+	clock       Clock
+	userAgent   string
+	signer      Signer
+	limiter     *RateLimiter
+	retryPolicy RetryPolicy
+}
+
+// Optional configuration of the sdk created with New.
+type Option func(options *options)
+
+// Connects to the given environment instead of EnvironmentProduction.
+func WithEnvironment(environment Environment) Option {
+	return func(options *options) {
+		options.environment = environment
+	}
+}
+
+// Sends the requests to the given base url instead of https://api.binance.com, without failover to other urls.
+// Useful to work with a local server or a deployment without a predefined environment. The environment is named
+// "custom" and has no web socket url.
+func WithBaseURL(baseUrl string) Option {
+	return func(options *options) {
+		options.environment = Environment{Name: "custom", RestURLs: []string{baseUrl}}
+	}
+}
+
+// Sends the requests with the given http client, so its transport can be shared and tuned.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(options *options) {
+		options.httpClient = httpClient
+	}
+}
+
+// Time limit for every request, including the connection and reading the response. There is no timeout by default.
+func WithTimeout(timeout time.Duration) Option {
+	return func(options *options) {
+		options.timeout = timeout
+	}
+}
+
+// Sends the requests through the given proxy. It is ignored when the client given with WithHTTPClient has a
+// transport that is not an *http.Transport, because the proxy cannot be set on it: configure the proxy in that
+// transport instead.
+func WithProxy(proxy *url.URL) Option {
+	return func(options *options) {
+		options.proxy = proxy
+	}
+}
+
+// Uses the given clock for the timestamp of signed requests instead of a clock synchronised with the server time.
+func WithClock(clock Clock) Option {
+	return func(options *options) {
+		options.clock = clock
+	}
+}
+
+// Sets the User-Agent header of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(options *options) {
+		options.userAgent = userAgent
+	}
+}
+
+// Signs the requests with the given signer instead of using the api secret as a HMAC key. Required for RSA and
+// Ed25519 api keys.
+func WithSigner(signer Signer) Option {
+	return func(options *options) {
+		options.signer = signer
+	}
+}
+
+// Uses the given rate limiter, so it can be shared between several sdks using the same IP or api key. A nil limiter
+// disables the rate limits.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(options *options) {
+		options.limiter = limiter
+	}
+}
+
+// Retries the failed requests with the given policy instead of DefaultRetryPolicy.
+func WithRetryPolicy(retryPolicy RetryPolicy) Option {
+	return func(options *options) {
+		options.retryPolicy = retryPolicy
+	}
+}
+
+// Returns a clock with the local time. Use it with WithClock to disable the synchronisation with the server time.
+func LocalClock() Clock {
+	return clock{}
+}
+
+func newOptions(apiSecret string, configuration []Option) *options {
+	options := &options{
+		environment: EnvironmentProduction,
+		signer:      NewHMACSigner(apiSecret),
+		limiter:     NewRateLimiter(),
+		retryPolicy: DefaultRetryPolicy(),
+	}
+	for _, option := range configuration {
+		option(options)
+	}
+	return options
+}
+
+// Builds the http client from the options without modifying the one given with WithHTTPClient.
+func (o *options) buildHTTPClient() *http.Client {
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		copied := *o.httpClient
+		httpClient = &copied
+	}
+
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	if o.proxy != nil {
+		transport, ok := httpClient.Transport.(*http.Transport)
+		if !ok && httpClient.Transport != nil {
+			return httpClient
+		}
+		if transport == nil {
+			transport = http.DefaultTransport.(*http.Transport)
+		}
+		transport = transport.Clone()
+		transport.Proxy = http.ProxyURL(o.proxy)
+		httpClient.Transport = transport
+	}
+
+	return httpClient
+}
@@ -0,0 +1,99 @@
+package binance
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type stubRoundTripper struct{}
+
+func (s *stubRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	return nil, errors.New("error")
+}
+
+func TestNew_Options(t *testing.T) {
+	t.Run("It should use the default configuration", func(t *testing.T) {
+		sdk := New("api-key", "api-secret")
+		client := sdk.client.(*client)
+
+		assert.Equal(t, EnvironmentProduction, sdk.Environment())
+		assert.Equal(t, EnvironmentProduction.RestURLs, client.baseUrls)
+		assert.NotNil(t, client.httpClient)
+		assert.Same(t, sdk.RateLimiter(), client.limiter)
+		assert.Same(t, sdk.RetryPolicy(), client.retryPolicy)
+	})
+
+	t.Run("It should apply the given options", func(t *testing.T) {
+		limiter := NewRateLimiter()
+		clock := LocalClock()
+		retryPolicy := RetryPolicy{MaxAttempts: 1}
+
+		sdk := New("api-key", "api-secret",
+			WithBaseURL("https://testnet.binance.vision"),
+			WithUserAgent("my-bot/1.0"),
+			WithClock(clock),
+			WithRateLimiter(limiter),
+			WithRetryPolicy(retryPolicy),
+			WithTimeout(5*time.Second),
+		)
+		client := sdk.client.(*client)
+
+		assert.Equal(t, Environment{Name: "custom", RestURLs: []string{"https://testnet.binance.vision"}}, sdk.Environment())
+		assert.Equal(t, []string{"https://testnet.binance.vision"}, client.baseUrls)
+		assert.Equal(t, "my-bot/1.0", client.userAgent)
+		assert.Equal(t, clock, sdk.clock)
+		assert.Same(t, limiter, sdk.RateLimiter())
+		assert.Equal(t, retryPolicy, *sdk.RetryPolicy())
+		assert.Equal(t, 5*time.Second, client.httpClient.Timeout)
+	})
+
+	t.Run("It should not modify the given http client", func(t *testing.T) {
+		httpClient := &http.Client{Timeout: time.Minute}
+		proxy, _ := url.Parse("http://proxy.local:8080")
+
+		sdk := New("api-key", "api-secret", WithHTTPClient(httpClient), WithTimeout(time.Second), WithProxy(proxy))
+		client := sdk.client.(*client)
+
+		assert.Equal(t, time.Minute, httpClient.Timeout)
+		assert.Nil(t, httpClient.Transport)
+		assert.Equal(t, time.Second, client.httpClient.Timeout)
+
+		proxyUrl, _ := client.httpClient.Transport.(*http.Transport).Proxy(&http.Request{})
+		assert.Equal(t, proxy, proxyUrl)
+	})
+
+	t.Run("It should keep a custom transport of the given http client", func(t *testing.T) {
+		transport := &stubRoundTripper{}
+		proxy, _ := url.Parse("http://proxy.local:8080")
+
+		sdk := New("api-key", "api-secret", WithHTTPClient(&http.Client{Transport: transport}), WithProxy(proxy))
+
+		assert.Same(t, transport, sdk.client.(*client).httpClient.Transport)
+	})
+
+	t.Run("It should disable the rate limits with a nil limiter", func(t *testing.T) {
+		sdk := New("api-key", "api-secret", WithRateLimiter(nil))
+
+		assert.Nil(t, sdk.RateLimiter())
+	})
+}
+
+func TestWithBaseURL(t *testing.T) {
+	server, mux := runLocalServer()
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/time", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-bot/1.0", r.Header.Get("User-Agent"))
+		w.Write([]byte(`{"serverTime": 1499827319559}`))
+	})
+
+	sdk := New("api-key", "api-secret", WithBaseURL(server.URL), WithUserAgent("my-bot/1.0"))
+	response, err := sdk.ServerTime()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ServerTime{ServerTime: 1499827319559}, response)
+}
@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type OrderAmendment struct {
+	Symbol            string  `json:"symbol"`
+	OrderId           int64   `json:"orderId"`
+	ExecutionId       int64   `json:"executionId"`
+	OrigClientOrderId string  `json:"origClientOrderId"`
+	NewClientOrderId  string  `json:"newClientOrderId"`
+	OriginalQuantity  Decimal `json:"origQty"`
+	NewQuantity       Decimal `json:"newQty"`
+	Time              int64   `json:"time"`
+}
+
+type getOrderAmendmentsQuery struct {
+	symbol          *string
+	orderId         *int64
+	fromExecutionId *int64
+	limit           *int64
+	recvWindow      *int64
+	timestamp       *int64
+}
+
+// The api only finds the amendments by order id.
+func NewGetOrderAmendmentsQuery(symbol string, orderId int64) *getOrderAmendmentsQuery {
+	return &getOrderAmendmentsQuery{
+		symbol:  &symbol,
+		orderId: &orderId,
+	}
+}
+
+func (r *getOrderAmendmentsQuery) FromExecutionId(value int64) *getOrderAmendmentsQuery {
+	r.fromExecutionId = &value
+	return r
+}
+
+func (r *getOrderAmendmentsQuery) Limit(value int64) *getOrderAmendmentsQuery {
+	r.limit = &value
+	return r
+}
+
+func (r *getOrderAmendmentsQuery) RecvWindow(value int64) *getOrderAmendmentsQuery {
+	r.recvWindow = &value
+	return r
+}
+
+func (sdk Sdk) GetOrderAmendments(query *getOrderAmendmentsQuery) ([]OrderAmendment, error) {
+	return sdk.GetOrderAmendmentsCtx(context.Background(), query)
+}
+
+func (sdk Sdk) GetOrderAmendmentsCtx(ctx context.Context, query *getOrderAmendmentsQuery) ([]OrderAmendment, error) {
+	req := newRequest("GET", "/api/v3/order/amendments").
+		StringParam("symbol", query.symbol).
+		Int64Param("orderId", query.orderId).
+		Int64Param("fromExecutionId", query.fromExecutionId).
+		Int64Param("limit", query.limit).
+		Int64Param("recvWindow", query.recvWindow).
+		Int64Param("timestamp", sdk.clock.Now()).
+		Sign()
+
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderAmendmentsResponse(responseContent)
+}
+
+func parseOrderAmendmentsResponse(jsonContent []byte) ([]OrderAmendment, error) {
+	response := make([]OrderAmendment, 0)
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
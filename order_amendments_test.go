@@ -0,0 +1,140 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_GetOrderAmendments(t *testing.T) {
+	method, url := "GET", "/api/v3/order/amendments"
+
+	t.Run("It should convert api response to a list of OrderAmendment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("orderId", "9").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderAmendmentsJson(), nil)
+
+		response, _ := sdk.GetOrderAmendments(NewGetOrderAmendmentsQuery("BTCUSDT", 9))
+
+		assert.Equal(t, validOrderAmendmentsResponse(), response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("orderId", "9").
+			Param("fromExecutionId", "70").
+			Param("limit", "10").
+			Param("recvWindow", "2").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderAmendmentsJson(), nil)
+
+		query := NewGetOrderAmendmentsQuery("BTCUSDT", 9).FromExecutionId(70).Limit(10).RecvWindow(2)
+		response, _ := sdk.GetOrderAmendments(query)
+
+		assert.Equal(t, validOrderAmendmentsResponse(), response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.GetOrderAmendments(NewGetOrderAmendmentsQuery("BTCUSDT", 9))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.GetOrderAmendments(NewGetOrderAmendmentsQuery("BTCUSDT", 9))
+
+		assert.Error(t, err)
+	})
+}
+
+func validOrderAmendmentsJson() []byte {
+	return []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"orderId": 9,
+			"executionId": 75,
+			"origClientOrderId": "original",
+			"newClientOrderId": "amended",
+			"origQty": "10.00000000",
+			"newQty": "5.00000000",
+			"time": 1741926410255
+		}
+	]`)
+}
+
+func validOrderAmendmentsResponse() []OrderAmendment {
+	return []OrderAmendment{
+		{
+			Symbol:            "BTCUSDT",
+			OrderId:           9,
+			ExecutionId:       75,
+			OrigClientOrderId: "original",
+			NewClientOrderId:  "amended",
+			OriginalQuantity:  MustDecimal("10"),
+			NewQuantity:       MustDecimal("5"),
+			Time:              1741926410255,
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package binance
+
+import "fmt"
+
+// Order of an order list. The parameters of each leg are sent with the prefix of the leg, like abovePrice. The side
+// and quantity are only set when they are not shared by all the orders of the list.
+type orderListLeg struct {
+	side            *OrderSide
+	orderType       *OrderType
+	quantity        *Decimal
+	clientOrderId   *string
+	price           *Decimal
+	stopPrice       *Decimal
+	trailingDelta   *int64
+	icebergQuantity *Decimal
+	timeInForce     *TimeInForce
+}
+
+// Checks the enums and the parameters that the type of the order requires, so the order list is not rejected
+// after being sent.
+func (l *orderListLeg) validate(prefix string) error {
+	if l.side != nil && !l.side.IsValid() {
+		return fmt.Errorf("invalid %sSide %q", prefix, *l.side)
+	}
+	if !l.orderType.IsValid() {
+		return fmt.Errorf("invalid %sType %q", prefix, *l.orderType)
+	}
+	if l.timeInForce != nil && !l.timeInForce.IsValid() {
+		return fmt.Errorf("invalid %sTimeInForce %q", prefix, *l.timeInForce)
+	}
+	if l.quantity != nil && l.quantity.Sign() <= 0 {
+		return fmt.Errorf("%sQuantity must be positive", prefix)
+	}
+
+	orderType := *l.orderType
+	if orderType.hasPrice() && l.price == nil {
+		return fmt.Errorf("%sPrice is required for %s orders", prefix, orderType)
+	}
+	if orderType.hasTimeInForce() && l.timeInForce == nil {
+		return fmt.Errorf("%sTimeInForce is required for %s orders", prefix, orderType)
+	}
+	if orderType.isStop() && l.stopPrice == nil && l.trailingDelta == nil {
+		return fmt.Errorf("%sStopPrice or %sTrailingDelta is required for %s orders", prefix, prefix, orderType)
+	}
+	return nil
+}
+
+func (l *orderListLeg) addParams(prefix string, r *request) *request {
+	return r.
+		StringParam(prefix+"Side", (*string)(l.side)).
+		StringParam(prefix+"Type", (*string)(l.orderType)).
+		DecimalParam(prefix+"Quantity", l.quantity).
+		StringParam(prefix+"ClientOrderId", l.clientOrderId).
+		DecimalParam(prefix+"Price", l.price).
+		DecimalParam(prefix+"StopPrice", l.stopPrice).
+		Int64Param(prefix+"TrailingDelta", l.trailingDelta).
+		DecimalParam(prefix+"IcebergQty", l.icebergQuantity).
+		StringParam(prefix+"TimeInForce", (*string)(l.timeInForce))
+}
+
+// Working order of OTO and OTOCO order lists, which triggers the pending orders when it is filled.
+func (l *orderListLeg) validateWorking() error {
+	if *l.orderType != OrderTypeLimit && *l.orderType != OrderTypeLimitMaker {
+		return fmt.Errorf("workingType must be LIMIT or LIMIT_MAKER, got %q", *l.orderType)
+	}
+	return l.validate("working")
+}
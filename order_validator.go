@@ -0,0 +1,326 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Checks new orders against the filters of a symbol before they are sent, so orders that the exchange would
+// reject fail without using request weight.
+//
+// The percent price filters and the notional of market orders need the average price of the symbol. They are not
+// checked until the average price is set. Sdk.OrderValidator returns a validator with the current average price.
+type OrderValidator struct {
+	symbol       Symbol
+	averagePrice *Decimal
+	autoRound    bool
+}
+
+// Filter of the symbol that an order does not pass. FilterType is empty when the order type, the iceberg quantity,
+// the quote order quantity or the trailing delta are not permitted by the symbol.
+type FilterViolation struct {
+	FilterType FilterType
+	Parameter  string
+	Message    string
+}
+
+// Error returned with all the filters that an order does not pass.
+type OrderValidationError struct {
+	Symbol     string
+	Violations []FilterViolation
+}
+
+func (e *OrderValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return fmt.Sprintf("order does not pass the filters of %s: %s", e.Symbol, strings.Join(messages, "; "))
+}
+
+func NewOrderValidator(symbol Symbol) *OrderValidator {
+	return &OrderValidator{symbol: symbol}
+}
+
+// Validator of the orders of the symbol with its current average price, so the percent price filters and the
+// notional of market orders are checked too.
+func (sdk Sdk) OrderValidator(symbol Symbol) (*OrderValidator, error) {
+	return sdk.OrderValidatorCtx(context.Background(), symbol)
+}
+
+func (sdk Sdk) OrderValidatorCtx(ctx context.Context, symbol Symbol) (*OrderValidator, error) {
+	averagePrice, err := sdk.AveragePriceCtx(ctx, NewAveragePriceQuery(symbol.Symbol))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrderValidator(symbol).AveragePrice(averagePrice.Price), nil
+}
+
+// Average price of the symbol used by the percent price filters and the notional of market orders.
+func (v *OrderValidator) AveragePrice(value Decimal) *OrderValidator {
+	v.averagePrice = &value
+	return v
+}
+
+// Rounds the prices to the tick size and the quantities down to the step size of the symbol before checking the
+// filters. The rounded values are set in the request, so they are the ones sent by NewOrder.
+func (v *OrderValidator) AutoRound() *OrderValidator {
+	v.autoRound = true
+	return v
+}
+
+// Returns an *OrderValidationError with all the filters that the order does not pass, or the error of the request
+// when its parameters cannot be combined.
+func (v *OrderValidator) Validate(request *newOrderRequest) error {
+	if err := request.validate(); err != nil {
+		return err
+	}
+	if *request.symbol != v.symbol.Symbol {
+		return fmt.Errorf("order of %s cannot be validated with the filters of %s", *request.symbol, v.symbol.Symbol)
+	}
+
+	if v.autoRound {
+		v.round(request)
+	}
+
+	checks := orderChecks{request: request, averagePrice: v.averagePrice}
+	checks.orderType(v.symbol)
+	for _, filter := range v.symbol.Filters {
+		checks.filter(filter)
+	}
+
+	if len(checks.violations) > 0 {
+		return &OrderValidationError{Symbol: v.symbol.Symbol, Violations: checks.violations}
+	}
+	return nil
+}
+
+func (v *OrderValidator) round(request *newOrderRequest) {
+	if priceFilter := v.symbol.PriceFilter(); priceFilter != nil {
+		request.price = roundToStep(request.price, priceFilter.TickSize)
+		request.stopPrice = roundToStep(request.stopPrice, priceFilter.TickSize)
+	}
+
+	if lotSize := v.symbol.LotSize(); lotSize != nil {
+		request.quantity = floorToStep(request.quantity, lotSize.StepSize)
+		request.icebergQuantity = floorToStep(request.icebergQuantity, lotSize.StepSize)
+	}
+	if marketLotSize := v.symbol.MarketLotSize(); marketLotSize != nil && *request.orderType == OrderTypeMarket {
+		request.quantity = floorToStep(request.quantity, marketLotSize.StepSize)
+	}
+}
+
+func roundToStep(value *Decimal, step Decimal) *Decimal {
+	if value == nil {
+		return nil
+	}
+	rounded := value.RoundToStep(step)
+	return &rounded
+}
+
+func floorToStep(value *Decimal, step Decimal) *Decimal {
+	if value == nil {
+		return nil
+	}
+	rounded := value.FloorToStep(step)
+	return &rounded
+}
+
+// Violations found while checking an order.
+type orderChecks struct {
+	request      *newOrderRequest
+	averagePrice *Decimal
+	violations   []FilterViolation
+}
+
+func (c *orderChecks) add(filterType FilterType, parameter string, format string, args ...interface{}) {
+	c.violations = append(c.violations, FilterViolation{
+		FilterType: filterType,
+		Parameter:  parameter,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *orderChecks) orderType(symbol Symbol) {
+	orderType := *c.request.orderType
+	if len(symbol.OrderTypes) > 0 && !containsOrderType(symbol.OrderTypes, orderType) {
+		c.add("", "type", "%s orders are not permitted", orderType)
+	}
+	if c.request.icebergQuantity != nil && !symbol.IcebergAllowed {
+		c.add("", "icebergQty", "iceberg orders are not permitted")
+	}
+	if c.request.quoteOrderQuantity != nil && !symbol.QuoteOrderQtyMarketAllowed {
+		c.add("", "quoteOrderQty", "quote order quantity market orders are not permitted")
+	}
+	if c.request.trailingDelta != nil && !symbol.AllowTrailingStop {
+		c.add("", "trailingDelta", "trailing stops are not permitted")
+	}
+}
+
+func containsOrderType(orderTypes []OrderType, orderType OrderType) bool {
+	for _, permitted := range orderTypes {
+		if permitted == orderType {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *orderChecks) filter(filter Filter) {
+	switch filter := filter.(type) {
+	case *PriceFilter:
+		c.price(filter, "price", c.request.price)
+		c.price(filter, "stopPrice", c.request.stopPrice)
+	case *LotSizeFilter:
+		c.quantity(FilterTypeLotSize, filter.MinQuantity, filter.MaxQuantity, filter.StepSize, "quantity", c.request.quantity)
+		c.quantity(FilterTypeLotSize, filter.MinQuantity, filter.MaxQuantity, filter.StepSize, "icebergQty", c.request.icebergQuantity)
+	case *MarketLotSizeFilter:
+		if *c.request.orderType == OrderTypeMarket {
+			c.quantity(FilterTypeMarketLotSize, filter.MinQuantity, filter.MaxQuantity, filter.StepSize, "quantity", c.request.quantity)
+		}
+	case *MinNotionalFilter:
+		if notional := c.notional(filter.ApplyToMarket); notional != nil && notional.LessThan(filter.MinNotional) {
+			c.add(FilterTypeMinNotional, "quantity", "notional %s is less than %s", notional, filter.MinNotional)
+		}
+	case *NotionalFilter:
+		if notional := c.notional(filter.ApplyMinToMarket); notional != nil && notional.LessThan(filter.MinNotional) {
+			c.add(FilterTypeNotional, "quantity", "notional %s is less than %s", notional, filter.MinNotional)
+		}
+		if notional := c.notional(filter.ApplyMaxToMarket); notional != nil && isAbove(*notional, filter.MaxNotional) {
+			c.add(FilterTypeNotional, "quantity", "notional %s is greater than %s", notional, filter.MaxNotional)
+		}
+	case *PercentPriceFilter:
+		c.percentPrice(FilterTypePercentPrice, filter.MultiplierDown, filter.MultiplierUp)
+	case *PercentPriceBySideFilter:
+		if *c.request.side == SideBuy {
+			c.percentPrice(FilterTypePercentPriceBySide, filter.BidMultiplierDown, filter.BidMultiplierUp)
+		} else {
+			c.percentPrice(FilterTypePercentPriceBySide, filter.AskMultiplierDown, filter.AskMultiplierUp)
+		}
+	case *IcebergPartsFilter:
+		c.icebergParts(filter)
+	case *TrailingDeltaFilter:
+		c.trailingDelta(filter)
+	}
+}
+
+func (c *orderChecks) price(filter *PriceFilter, parameter string, price *Decimal) {
+	if price == nil {
+		return
+	}
+
+	if filter.MinPrice.Sign() > 0 && price.LessThan(filter.MinPrice) {
+		c.add(FilterTypePrice, parameter, "%s %s is less than %s", parameter, price, filter.MinPrice)
+	}
+	if isAbove(*price, filter.MaxPrice) {
+		c.add(FilterTypePrice, parameter, "%s %s is greater than %s", parameter, price, filter.MaxPrice)
+	}
+	if !isMultiple(price.Sub(filter.MinPrice), filter.TickSize) {
+		c.add(FilterTypePrice, parameter, "%s %s is not a multiple of the tick size %s", parameter, price, filter.TickSize)
+	}
+}
+
+func (c *orderChecks) quantity(filterType FilterType, min, max, step Decimal, parameter string, quantity *Decimal) {
+	if quantity == nil {
+		return
+	}
+
+	if quantity.LessThan(min) {
+		c.add(filterType, parameter, "%s %s is less than %s", parameter, quantity, min)
+	}
+	if isAbove(*quantity, max) {
+		c.add(filterType, parameter, "%s %s is greater than %s", parameter, quantity, max)
+	}
+	if !isMultiple(quantity.Sub(min), step) {
+		c.add(filterType, parameter, "%s %s is not a multiple of the step size %s", parameter, quantity, step)
+	}
+}
+
+// Price * quantity of the order. Market orders use the average price and nil is returned when they are not
+// checked or the average price is unknown.
+func (c *orderChecks) notional(applyToMarket bool) *Decimal {
+	if c.request.quantity == nil {
+		if !applyToMarket {
+			return nil
+		}
+		return c.request.quoteOrderQuantity
+	}
+
+	price := c.request.price
+	if *c.request.orderType == OrderTypeMarket {
+		if !applyToMarket {
+			return nil
+		}
+		price = c.averagePrice
+	}
+	if price == nil {
+		return nil
+	}
+
+	notional := price.Mul(*c.request.quantity)
+	return &notional
+}
+
+func (c *orderChecks) percentPrice(filterType FilterType, multiplierDown, multiplierUp Decimal) {
+	price := c.request.price
+	if price == nil || c.averagePrice == nil {
+		return
+	}
+
+	if min := c.averagePrice.Mul(multiplierDown); price.LessThan(min) {
+		c.add(filterType, "price", "price %s is less than %s, %s times the average price", price, min, multiplierDown)
+	}
+	if max := c.averagePrice.Mul(multiplierUp); price.GreaterThan(max) {
+		c.add(filterType, "price", "price %s is greater than %s, %s times the average price", price, max, multiplierUp)
+	}
+}
+
+func (c *orderChecks) icebergParts(filter *IcebergPartsFilter) {
+	quantity, icebergQuantity := c.request.quantity, c.request.icebergQuantity
+	if quantity == nil || icebergQuantity == nil || icebergQuantity.Sign() <= 0 {
+		return
+	}
+
+	// Number of parts rounded up: the last part has the rest of the quantity.
+	parts := quantity.Div(*icebergQuantity, 0)
+	if !parts.Mul(*icebergQuantity).Equal(*quantity) {
+		parts = parts.Add(NewDecimal(1, 0))
+	}
+	if parts.GreaterThan(NewDecimal(int64(filter.Limit), 0)) {
+		c.add(FilterTypeIcebergParts, "icebergQty", "iceberg orders cannot have more than %d parts, got %s", filter.Limit, parts)
+	}
+}
+
+func (c *orderChecks) trailingDelta(filter *TrailingDeltaFilter) {
+	delta := c.request.trailingDelta
+	if delta == nil {
+		return
+	}
+
+	min, max := filter.MinTrailingBelowDelta, filter.MaxTrailingBelowDelta
+	if c.request.isTrailingAbove() {
+		min, max = filter.MinTrailingAboveDelta, filter.MaxTrailingAboveDelta
+	}
+	if *delta < min || *delta > max {
+		c.add(FilterTypeTrailingDelta, "trailingDelta", "trailingDelta %d is out of the range %d-%d", *delta, min, max)
+	}
+}
+
+// Reports whether the trailing stop is triggered when the price goes up, which uses the above limits of the
+// trailing delta filter.
+func (r *newOrderRequest) isTrailingAbove() bool {
+	stopLoss := *r.orderType == OrderTypeStopLoss || *r.orderType == OrderTypeStopLossLimit
+	return stopLoss == (*r.side == SideBuy)
+}
+
+// Maximum values of zero mean that there is no limit.
+func isAbove(value Decimal, max Decimal) bool {
+	return max.Sign() > 0 && value.GreaterThan(max)
+}
+
+// Steps of zero mean that any value is valid.
+func isMultiple(value Decimal, step Decimal) bool {
+	return step.Sign() <= 0 || value.FloorToStep(step).Equal(value)
+}
@@ -0,0 +1,216 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOrderValidator_Validate(t *testing.T) {
+	t.Run("It should accept an order that passes all the filters", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeLimit, MustDecimal("1.5")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("0.05"))
+
+		err := NewOrderValidator(validatorSymbol()).AveragePrice(MustDecimal("0.05")).Validate(request)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("It should return all the violations of the order", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeLimit, MustDecimal("0.0015")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("0.123456"))
+
+		err := NewOrderValidator(validatorSymbol()).AveragePrice(MustDecimal("0.05")).Validate(request)
+
+		assert.Equal(t, &OrderValidationError{
+			Symbol: "ETHBTC",
+			Violations: []FilterViolation{
+				{FilterType: FilterTypePrice, Parameter: "price", Message: "price 0.123456 is not a multiple of the tick size 0.0001"},
+				{FilterType: FilterTypeLotSize, Parameter: "quantity", Message: "quantity 0.0015 is not a multiple of the step size 0.001"},
+				{FilterType: FilterTypeMinNotional, Parameter: "quantity", Message: "notional 0.000185184 is less than 0.001"},
+				{FilterType: FilterTypePercentPrice, Parameter: "price", Message: "price 0.123456 is greater than 0.1, 2 times the average price"},
+			},
+		}, err)
+		assert.EqualError(t, err, "order does not pass the filters of ETHBTC: "+
+			"price 0.123456 is not a multiple of the tick size 0.0001; "+
+			"quantity 0.0015 is not a multiple of the step size 0.001; "+
+			"notional 0.000185184 is less than 0.001; "+
+			"price 0.123456 is greater than 0.1, 2 times the average price")
+	})
+
+	t.Run("It should check the order type and the iceberg permission", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeTakeProfitLimit, MustDecimal("1")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("0.05")).
+			StopPrice(MustDecimal("0.05")).
+			IcebergQuantity(MustDecimal("0.1"))
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+
+		assert.Equal(t, []FilterViolation{
+			{Parameter: "type", Message: "TAKE_PROFIT_LIMIT orders are not permitted"},
+			{Parameter: "icebergQty", Message: "iceberg orders are not permitted"},
+			{FilterType: FilterTypeIcebergParts, Parameter: "icebergQty", Message: "iceberg orders cannot have more than 5 parts, got 10"},
+		}, err.(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should check the quote order quantity and the trailing stop permissions", func(t *testing.T) {
+		symbol := validatorSymbol()
+		symbol.QuoteOrderQtyMarketAllowed = false
+		symbol.AllowTrailingStop = false
+		validator := NewOrderValidator(symbol)
+
+		err := validator.Validate(NewQuoteOrderRequest("ETHBTC", SideBuy, MustDecimal("1")))
+		assert.Equal(t, []FilterViolation{
+			{Parameter: "quoteOrderQty", Message: "quote order quantity market orders are not permitted"},
+		}, err.(*OrderValidationError).Violations)
+
+		err = validator.Validate(NewOrderRequest("ETHBTC", SideSell, OrderTypeStopLoss, MustDecimal("1")).TrailingDelta(100))
+		assert.Equal(t, []FilterViolation{
+			{Parameter: "trailingDelta", Message: "trailing stops are not permitted"},
+		}, err.(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should check the limits of the quantity and the price", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideSell, OrderTypeLimit, MustDecimal("200000")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("200000"))
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+
+		assert.Equal(t, []FilterViolation{
+			{FilterType: FilterTypePrice, Parameter: "price", Message: "price 200000 is greater than 100000"},
+			{FilterType: FilterTypeLotSize, Parameter: "quantity", Message: "quantity 200000 is greater than 100000"},
+			{FilterType: FilterTypeNotional, Parameter: "quantity", Message: "notional 40000000000 is greater than 9000000"},
+		}, err.(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should use the average price for the notional of market orders", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeMarket, MustDecimal("0.01"))
+
+		validator := NewOrderValidator(validatorSymbol())
+		assert.Nil(t, validator.Validate(request))
+
+		err := validator.AveragePrice(MustDecimal("0.05")).Validate(request)
+		assert.Equal(t, []FilterViolation{
+			{FilterType: FilterTypeMinNotional, Parameter: "quantity", Message: "notional 0.0005 is less than 0.001"},
+		}, err.(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should check the market lot size of market orders", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeMarket, MustDecimal("150"))
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+
+		assert.Equal(t, []FilterViolation{
+			{FilterType: FilterTypeMarketLotSize, Parameter: "quantity", Message: "quantity 150 is greater than 100"},
+		}, err.(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should check the trailing delta by the direction of the stop", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeStopLoss, MustDecimal("1")).TrailingDelta(1500)
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+		assert.Equal(t, []FilterViolation{
+			{FilterType: FilterTypeTrailingDelta, Parameter: "trailingDelta", Message: "trailingDelta 1500 is out of the range 10-1000"},
+		}, err.(*OrderValidationError).Violations)
+
+		request = NewOrderRequest("ETHBTC", SideSell, OrderTypeStopLoss, MustDecimal("1")).TrailingDelta(1500)
+		assert.Nil(t, NewOrderValidator(validatorSymbol()).Validate(request))
+	})
+
+	t.Run("It should round the price and the quantity when auto round is enabled", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeLimit, MustDecimal("1.5678")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("0.050061"))
+
+		err := NewOrderValidator(validatorSymbol()).AutoRound().Validate(request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, MustDecimal("1.567"), *request.quantity)
+		assert.Equal(t, MustDecimal("0.0501"), *request.price)
+	})
+
+	t.Run("It should return the error of the request parameters", func(t *testing.T) {
+		request := NewOrderRequest("ETHBTC", SideBuy, OrderTypeLimit, MustDecimal("1"))
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+
+		assert.EqualError(t, err, "price is required for LIMIT orders")
+	})
+
+	t.Run("It should return error when the order is of another symbol", func(t *testing.T) {
+		request := NewOrderRequest("BNBBTC", SideBuy, OrderTypeMarket, MustDecimal("1"))
+
+		err := NewOrderValidator(validatorSymbol()).Validate(request)
+
+		assert.EqualError(t, err, "order of BNBBTC cannot be validated with the filters of ETHBTC")
+	})
+}
+
+func TestSdk_OrderValidator(t *testing.T) {
+	method, url := "GET", "/api/v3/avgPrice"
+
+	t.Run("It should check the percent price filters with the average price", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("symbol", "ETHBTC")).
+			MinTimes(1).
+			Return(validAveragePriceJson(), nil)
+
+		validator, _ := sdk.OrderValidator(validatorSymbol())
+		request := NewOrderRequest("ETHBTC", SideSell, OrderTypeLimit, MustDecimal("1")).
+			TimeInForce(TimeInForceGTC).
+			Price(MustDecimal("0.01"))
+
+		assert.Equal(t, NewOrderValidator(validatorSymbol()).AveragePrice(MustDecimal("0.05")), validator)
+		assert.Equal(t, []FilterViolation{
+			{FilterType: FilterTypePercentPrice, Parameter: "price", Message: "price 0.01 is less than 0.025, 0.5 times the average price"},
+		}, validator.Validate(request).(*OrderValidationError).Violations)
+	})
+
+	t.Run("It should return error when the average price cannot be requested", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("symbol", "ETHBTC")).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.OrderValidator(validatorSymbol())
+
+		assert.Error(t, err)
+	})
+}
+
+func validatorSymbol() Symbol {
+	return Symbol{
+		Symbol:                     "ETHBTC",
+		OrderTypes:                 []OrderType{OrderTypeLimit, OrderTypeMarket, OrderTypeStopLoss},
+		QuoteOrderQtyMarketAllowed: true,
+		AllowTrailingStop:          true,
+		Filters: Filters{
+			&PriceFilter{MinPrice: MustDecimal("0.0001"), MaxPrice: MustDecimal("100000"), TickSize: MustDecimal("0.0001")},
+			&LotSizeFilter{MinQuantity: MustDecimal("0.001"), MaxQuantity: MustDecimal("100000"), StepSize: MustDecimal("0.001")},
+			&MarketLotSizeFilter{MaxQuantity: MustDecimal("100")},
+			&MinNotionalFilter{MinNotional: MustDecimal("0.001"), ApplyToMarket: true, AvgPriceMins: 5},
+			&NotionalFilter{MaxNotional: MustDecimal("9000000")},
+			&PercentPriceFilter{MultiplierUp: MustDecimal("2"), MultiplierDown: MustDecimal("0.5"), AvgPriceMins: 5},
+			&IcebergPartsFilter{Limit: 5},
+			&TrailingDeltaFilter{
+				MinTrailingAboveDelta: 10,
+				MaxTrailingAboveDelta: 1000,
+				MinTrailingBelowDelta: 10,
+				MaxTrailingBelowDelta: 2000,
+			},
+		},
+	}
+}
@@ -0,0 +1,388 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitRequestWeight = "REQUEST_WEIGHT"
+	RateLimitOrders        = "ORDERS"
+	RateLimitRawRequests   = "RAW_REQUESTS"
+)
+
+// Returned by a fail fast RateLimiter when a request would exceed one of the limits.
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// Limits used until they are updated with the ones returned by ExchangeInfo.
+var defaultRateLimits = []RateLimits{
+	{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+	{RateLimitType: RateLimitOrders, Interval: "SECOND", IntervalNum: 10, Limit: 100},
+	{RateLimitType: RateLimitOrders, Interval: "DAY", IntervalNum: 1, Limit: 200000},
+	{RateLimitType: RateLimitRawRequests, Interval: "MINUTE", IntervalNum: 5, Limit: 61000},
+}
+
+// Weight of the endpoints that do not cost 1. Some of them are calculated by requestCost from the parameters.
+var endpointWeights = map[string]int{
+	"GET /api/v1/historicalTrades":         25,
+	"GET /api/v1/aggTrades":                2,
+	"GET /api/v1/klines":                   2,
+	"GET /api/v3/exchangeInfo":             20,
+	"GET /api/v3/avgPrice":                 2,
+	"GET /api/v3/order":                    4,
+	"GET /api/v3/allOrders":                20,
+	"GET /api/v3/account":                  20,
+	"GET /api/v3/myTrades":                 20,
+	"GET /api/v3/orderList":                4,
+	"GET /api/v3/allOrderList":             20,
+	"GET /api/v3/openOrderList":            6,
+	"PUT /api/v3/order/amend/keepPriority": 4,
+	"GET /api/v3/order/amendments":         4,
+}
+
+// Endpoints that count as new orders for the ORDERS limits.
+var endpointOrders = map[string]int{
+	"POST /api/v3/order":               1,
+	"POST /api/v3/order/cancelReplace": 1,
+	"POST /api/v3/orderList/oco":       2,
+	"POST /api/v3/orderList/oto":       2,
+	"POST /api/v3/orderList/otoco":     3,
+}
+
+// Usage of a rate limit in the current interval.
+type RateLimitUsage struct {
+	RateLimitType string
+	Interval      time.Duration
+	Limit         int
+	Used          int
+}
+
+type rateLimitCounter struct {
+	RateLimitUsage
+	windowStart time.Time
+}
+
+// Keeps track of the request weight and the order count to avoid exceeding the api limits.
+//
+// The usage is counted before sending each request and corrected with the X-MBX-USED-WEIGHT-* and
+// X-MBX-ORDER-COUNT-* headers of the responses. When a request would exceed a limit the limiter waits until the
+// interval ends, or returns ErrRateLimitExceeded if it is configured to fail fast. After a 429 or 418 response it
+// holds every request back in the same way until the time Binance asked to wait. It is safe to share it between
+// goroutines.
+type RateLimiter struct {
+	mutex        sync.Mutex
+	counters     []*rateLimitCounter
+	failFast     bool
+	blockedUntil time.Time
+	now          func() time.Time
+}
+
+// Returns a rate limiter with the default Binance limits.
+func NewRateLimiter() *RateLimiter {
+	limiter := &RateLimiter{now: time.Now}
+	limiter.SetLimits(defaultRateLimits)
+	return limiter
+}
+
+// Replaces the limits, usually with the ones returned by ExchangeInfo. The usage of the intervals that remain
+// is kept.
+func (l *RateLimiter) SetLimits(limits []RateLimits) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	counters := make([]*rateLimitCounter, 0, len(limits))
+	for _, limit := range limits {
+		counter := &rateLimitCounter{RateLimitUsage: RateLimitUsage{
+			RateLimitType: rateLimitType(limit.RateLimitType),
+			Interval:      rateLimitInterval(limit.Interval, limit.IntervalNum),
+			Limit:         limit.Limit,
+		}}
+		if previous := l.counter(counter.RateLimitType, counter.Interval); previous != nil {
+			counter.Used, counter.windowStart = previous.Used, previous.windowStart
+		}
+		counters = append(counters, counter)
+	}
+	l.counters = counters
+}
+
+// Returns ErrRateLimitExceeded instead of waiting when a limit is reached.
+func (l *RateLimiter) FailFast(value bool) *RateLimiter {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.failFast = value
+	return l
+}
+
+// Current usage of every limit.
+func (l *RateLimiter) Usage() []RateLimitUsage {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	usage := make([]RateLimitUsage, 0, len(l.counters))
+	for _, counter := range l.counters {
+		counter.resetExpired(now)
+		usage = append(usage, counter.RateLimitUsage)
+	}
+	return usage
+}
+
+func (l *RateLimiter) reserve(ctx context.Context, request *request) error {
+	weight, orders := requestCost(request)
+
+	for {
+		wait, failFast := l.tryReserve(weight, orders)
+		if wait == 0 {
+			return nil
+		}
+		if failFast {
+			return ErrRateLimitExceeded
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *RateLimiter) tryReserve(weight int, orders int) (time.Duration, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	wait := l.blockedUntil.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	for _, counter := range l.counters {
+		counter.resetExpired(now)
+		cost := counter.cost(weight, orders)
+		if cost > 0 && counter.Used > 0 && counter.Used+cost > counter.Limit {
+			if untilReset := counter.windowStart.Add(counter.Interval).Sub(now); untilReset > wait {
+				wait = untilReset
+			}
+		}
+	}
+
+	if wait > 0 {
+		return wait, l.failFast
+	}
+
+	for _, counter := range l.counters {
+		counter.Used += counter.cost(weight, orders)
+	}
+	return 0, false
+}
+
+func (l *RateLimiter) update(header http.Header) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	for key, values := range header {
+		key = strings.ToUpper(key)
+
+		var limitType, interval string
+		switch {
+		case strings.HasPrefix(key, "X-MBX-USED-WEIGHT-"):
+			limitType, interval = RateLimitRequestWeight, strings.TrimPrefix(key, "X-MBX-USED-WEIGHT-")
+		case strings.HasPrefix(key, "X-MBX-ORDER-COUNT-"):
+			limitType, interval = RateLimitOrders, strings.TrimPrefix(key, "X-MBX-ORDER-COUNT-")
+		default:
+			continue
+		}
+
+		used, err := strconv.Atoi(values[0])
+		counter := l.counter(limitType, parseHeaderInterval(interval))
+		if err != nil || counter == nil {
+			continue
+		}
+
+		counter.resetExpired(now)
+		if used > counter.Used {
+			counter.Used = used
+		}
+	}
+}
+
+// Holds every request back until the time to wait sent with a 429 or 418 response.
+func (l *RateLimiter) block(apiError *APIError) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	until := apiError.BannedUntil
+	if retryAt := l.now().Add(apiError.RetryAfter); apiError.RetryAfter > 0 && retryAt.After(until) {
+		until = retryAt
+	}
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+func (l *RateLimiter) counter(limitType string, interval time.Duration) *rateLimitCounter {
+	for _, counter := range l.counters {
+		if counter.RateLimitType == limitType && counter.Interval == interval {
+			return counter
+		}
+	}
+	return nil
+}
+
+// Binance resets the counters at the start of every interval, so the windows are aligned to them too.
+func (c *rateLimitCounter) resetExpired(now time.Time) {
+	if windowStart := now.Truncate(c.Interval); !windowStart.Equal(c.windowStart) {
+		c.windowStart = windowStart
+		c.Used = 0
+	}
+}
+
+func (c *rateLimitCounter) cost(weight int, orders int) int {
+	switch c.RateLimitType {
+	case RateLimitRequestWeight:
+		return weight
+	case RateLimitOrders:
+		return orders
+	case RateLimitRawRequests:
+		return 1
+	}
+	return 0
+}
+
+// Older versions of the api named the request weight limit as REQUESTS.
+func rateLimitType(value string) string {
+	if value == "REQUESTS" {
+		return RateLimitRequestWeight
+	}
+	return value
+}
+
+func rateLimitInterval(interval string, intervalNum int) time.Duration {
+	if intervalNum == 0 {
+		intervalNum = 1
+	}
+
+	unit := time.Minute
+	switch interval {
+	case "SECOND":
+		unit = time.Second
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	}
+	return time.Duration(intervalNum) * unit
+}
+
+// Parses intervals like 1M or 10S used in the headers.
+func parseHeaderInterval(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	intervalNum, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil {
+		return 0
+	}
+
+	switch value[len(value)-1] {
+	case 'S':
+		return rateLimitInterval("SECOND", intervalNum)
+	case 'M':
+		return rateLimitInterval("MINUTE", intervalNum)
+	case 'H':
+		return rateLimitInterval("HOUR", intervalNum)
+	case 'D':
+		return rateLimitInterval("DAY", intervalNum)
+	}
+	return 0
+}
+
+func requestCost(request *request) (weight int, orders int) {
+	endpoint := request.method + " " + request.path
+	orders = endpointOrders[endpoint]
+
+	switch endpoint {
+	case "GET /api/v1/depth":
+		return depthWeight(request.parameters.Get("limit")), orders
+	case "GET /api/v3/ticker/price", "GET /api/v3/ticker/bookTicker":
+		if request.parameters.Get("symbol") == "" {
+			return 4, orders
+		}
+		return 2, orders
+	case "GET /api/v3/ticker/24hr":
+		return ticker24hWeight(request.parameters), orders
+	case "GET /api/v3/ticker", "GET /api/v3/ticker/tradingDay":
+		return tickerWeight(request.parameters), orders
+	case "POST /api/v3/order/test":
+		if request.parameters.Get("computeCommissionRates") == "true" {
+			return 20, orders
+		}
+		return 1, orders
+	case "GET /api/v3/openOrders":
+		if request.parameters.Get("symbol") == "" {
+			return 80, orders
+		}
+		return 6, orders
+	}
+
+	if weight, ok := endpointWeights[endpoint]; ok {
+		return weight, orders
+	}
+	return 1, orders
+}
+
+// Weight of the 24 hour ticker, which depends on the number of symbols. All the symbols are requested when there
+// is no symbol.
+func ticker24hWeight(parameters parameterList) int {
+	symbols := symbolCount(parameters)
+	switch {
+	case symbols == 0 || symbols > 100:
+		return 80
+	case symbols > 20:
+		return 40
+	}
+	return 2
+}
+
+// Weight of the rolling window and trading day tickers: 4 per symbol, up to 200.
+func tickerWeight(parameters parameterList) int {
+	if symbols := symbolCount(parameters); symbols <= 50 {
+		return 4 * symbols
+	}
+	return 200
+}
+
+// Number of symbols sent in the symbol or the symbols parameters.
+func symbolCount(parameters parameterList) int {
+	if parameters.Get("symbol") != "" {
+		return 1
+	}
+
+	symbols := make([]string, 0)
+	json.Unmarshal([]byte(parameters.Get("symbols")), &symbols)
+	return len(symbols)
+}
+
+func depthWeight(limit string) int {
+	value, _ := strconv.Atoi(limit)
+	switch {
+	case value > 1000 || value == 0:
+		return 250
+	case value > 500:
+		return 50
+	case value > 100:
+		return 25
+	}
+	return 5
+}
@@ -0,0 +1,175 @@
+package binance
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(limits ...RateLimits) *RateLimiter {
+	limiter := &RateLimiter{now: func() time.Time { return time.Unix(1000, 0) }}
+	limiter.SetLimits(limits)
+	return limiter
+}
+
+func weightOf(request *request) int {
+	weight, _ := requestCost(request)
+	return weight
+}
+
+// Json array with the given number of symbols.
+func symbolsParam(count int) string {
+	return `["` + strings.Repeat(`BTCUSDT","`, count-1) + `BTCUSDT"]`
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	t.Run("It should count the weight and the orders of every request", func(t *testing.T) {
+		limiter := newTestRateLimiter(
+			RateLimits{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+			RateLimits{RateLimitType: RateLimitOrders, Interval: "SECOND", IntervalNum: 10, Limit: 100},
+			RateLimits{RateLimitType: RateLimitRawRequests, Interval: "MINUTE", IntervalNum: 5, Limit: 61000},
+		)
+
+		limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account"))
+		limiter.reserve(context.Background(), newRequest("POST", "/api/v3/order"))
+		limiter.reserve(context.Background(), newRequest("GET", "/api/v1/depth").Param("limit", "500"))
+
+		assert.Equal(t, []RateLimitUsage{
+			{RateLimitType: RateLimitRequestWeight, Interval: time.Minute, Limit: 6000, Used: 46},
+			{RateLimitType: RateLimitOrders, Interval: 10 * time.Second, Limit: 100, Used: 1},
+			{RateLimitType: RateLimitRawRequests, Interval: 5 * time.Minute, Limit: 61000, Used: 3},
+		}, limiter.Usage())
+	})
+
+	t.Run("It should count every order of the order lists", func(t *testing.T) {
+		ordersOf := func(request *request) int {
+			_, orders := requestCost(request)
+			return orders
+		}
+
+		assert.Equal(t, 1, ordersOf(newRequest("POST", "/api/v3/order")))
+		assert.Equal(t, 2, ordersOf(newRequest("POST", "/api/v3/orderList/oco")))
+		assert.Equal(t, 2, ordersOf(newRequest("POST", "/api/v3/orderList/oto")))
+		assert.Equal(t, 3, ordersOf(newRequest("POST", "/api/v3/orderList/otoco")))
+		assert.Equal(t, 0, ordersOf(newRequest("GET", "/api/v3/orderList")))
+	})
+
+	t.Run("It should weigh the 24 hour ticker by the number of symbols", func(t *testing.T) {
+		ticker := func() *request { return newRequest("GET", "/api/v3/ticker/24hr") }
+
+		assert.Equal(t, 2, weightOf(ticker().Param("symbol", "BTCUSDT")))
+		assert.Equal(t, 2, weightOf(ticker().Param("symbols", symbolsParam(20))))
+		assert.Equal(t, 40, weightOf(ticker().Param("symbols", symbolsParam(21))))
+		assert.Equal(t, 80, weightOf(ticker().Param("symbols", symbolsParam(101))))
+		assert.Equal(t, 80, weightOf(ticker()))
+	})
+
+	t.Run("It should weigh the rolling window tickers by the number of symbols", func(t *testing.T) {
+
+		assert.Equal(t, 4, weightOf(newRequest("GET", "/api/v3/ticker").Param("symbol", "BTCUSDT")))
+		assert.Equal(t, 200, weightOf(newRequest("GET", "/api/v3/ticker").Param("symbols", symbolsParam(50))))
+		assert.Equal(t, 200, weightOf(newRequest("GET", "/api/v3/ticker").Param("symbols", symbolsParam(51))))
+		assert.Equal(t, 12, weightOf(newRequest("GET", "/api/v3/ticker/tradingDay").Param("symbols", symbolsParam(3))))
+	})
+
+	t.Run("It should fail fast when a limit would be exceeded", func(t *testing.T) {
+		limiter := newTestRateLimiter(RateLimits{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", Limit: 30}).
+			FailFast(true)
+
+		assert.NoError(t, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account")))
+		assert.Equal(t, ErrRateLimitExceeded, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account")))
+		assert.NoError(t, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/time")))
+	})
+
+	t.Run("It should wait until the interval ends", func(t *testing.T) {
+		limiter := newTestRateLimiter(RateLimits{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", Limit: 30})
+		limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, context.DeadlineExceeded, limiter.reserve(ctx, newRequest("GET", "/api/v3/account")))
+	})
+
+	t.Run("It should reset the usage when the interval ends", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		limiter := &RateLimiter{now: func() time.Time { return now }}
+		limiter.SetLimits([]RateLimits{{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", Limit: 30}})
+		limiter.FailFast(true)
+
+		limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account"))
+		now = now.Add(time.Minute)
+
+		assert.NoError(t, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account")))
+	})
+}
+
+func TestRateLimiter_Block(t *testing.T) {
+	t.Run("It should hold every request back for the retry after delay", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		limiter := &RateLimiter{now: func() time.Time { return now }}
+		limiter.SetLimits(defaultRateLimits)
+		limiter.FailFast(true)
+
+		limiter.block(&APIError{StatusCode: 429, RetryAfter: 30 * time.Second})
+
+		assert.Equal(t, ErrRateLimitExceeded, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/time")))
+		now = now.Add(30 * time.Second)
+		assert.NoError(t, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/time")))
+	})
+
+	t.Run("It should hold every request back until the ban ends", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		limiter := &RateLimiter{now: func() time.Time { return now }}
+		limiter.SetLimits(defaultRateLimits)
+		limiter.FailFast(true)
+
+		limiter.block(&APIError{StatusCode: 418, RetryAfter: time.Minute, BannedUntil: now.Add(time.Hour)})
+
+		now = now.Add(time.Minute)
+		assert.Equal(t, ErrRateLimitExceeded, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/time")))
+		now = now.Add(time.Hour)
+		assert.NoError(t, limiter.reserve(context.Background(), newRequest("GET", "/api/v3/time")))
+	})
+
+	t.Run("It should wait until the block ends", func(t *testing.T) {
+		limiter := newTestRateLimiter(defaultRateLimits...)
+		limiter.block(&APIError{StatusCode: 429, RetryAfter: time.Minute})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, context.DeadlineExceeded, limiter.reserve(ctx, newRequest("GET", "/api/v3/time")))
+	})
+}
+
+func TestRateLimiter_Update(t *testing.T) {
+	limiter := newTestRateLimiter(defaultRateLimits...)
+
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "1500")
+	header.Set("X-MBX-ORDER-COUNT-10S", "7")
+	header.Set("X-MBX-ORDER-COUNT-1D", "25")
+	limiter.update(header)
+
+	assert.Equal(t, []RateLimitUsage{
+		{RateLimitType: RateLimitRequestWeight, Interval: time.Minute, Limit: 6000, Used: 1500},
+		{RateLimitType: RateLimitOrders, Interval: 10 * time.Second, Limit: 100, Used: 7},
+		{RateLimitType: RateLimitOrders, Interval: 24 * time.Hour, Limit: 200000, Used: 25},
+		{RateLimitType: RateLimitRawRequests, Interval: 5 * time.Minute, Limit: 61000},
+	}, limiter.Usage())
+}
+
+func TestRateLimiter_SetLimits(t *testing.T) {
+	limiter := newTestRateLimiter(RateLimits{RateLimitType: "REQUESTS", Interval: "MINUTE", Limit: 1200})
+	limiter.reserve(context.Background(), newRequest("GET", "/api/v3/account"))
+
+	limiter.SetLimits([]RateLimits{{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 6000}})
+
+	assert.Equal(t, []RateLimitUsage{
+		{RateLimitType: RateLimitRequestWeight, Interval: time.Minute, Limit: 6000, Used: 20},
+	}, limiter.Usage())
+}
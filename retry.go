@@ -0,0 +1,89 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// Defines when and how often a failed request is sent again.
+//
+// Connection errors, 5xx responses and 429 responses are retried with an exponential backoff with jitter, waiting
+// at least the Retry-After time sent by the api. Responses with status 418 mean that the IP is banned, so they are
+// never retried.
+//
+// By default only GET requests are retried, and RetryUnsafe allows to retry the rest. Requests that place orders are
+// the exception: they are retried only when they never reached Binance, because the connection could not be
+// established or the response has status 429, since a 5xx response does not tell whether the order was placed, even
+// with a client order id.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	RetryUnsafe bool
+}
+
+// Policy used by New.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+	}
+}
+
+// Returns the time to wait before sending again the request that failed in the given attempt.
+func (p *RetryPolicy) retryDelay(request *request, attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !p.canRetry(request, err) {
+		return 0, false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrRateLimitExceeded) {
+		return 0, false
+	}
+
+	delay := p.backoff(attempt)
+
+	apiError := &APIError{}
+	if errors.As(err, &apiError) {
+		if apiError.StatusCode == 429 && apiError.RetryAfter > delay {
+			return apiError.RetryAfter, true
+		}
+		return delay, apiError.StatusCode == 429 || apiError.StatusCode >= 500
+	}
+
+	return delay, true
+}
+
+func (p *RetryPolicy) canRetry(request *request, err error) bool {
+	if _, placesOrders := endpointOrders[request.method+" "+request.path]; placesOrders {
+		apiError := &APIError{}
+		return isUnreachable(err) || errors.As(err, &apiError) && apiError.StatusCode == 429
+	}
+	return request.method == "GET" || p.RetryUnsafe
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MaxDelay
+	if attempt < 32 && p.BaseDelay<<uint(attempt-1) < p.MaxDelay {
+		delay = p.BaseDelay << uint(attempt-1)
+	}
+
+	if delay <= 1 {
+		return delay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
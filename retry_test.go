@@ -0,0 +1,89 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_RetryDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	get := newRequest("GET", "/api/v3/account")
+
+	t.Run("It should retry connection errors and server errors of safe requests", func(t *testing.T) {
+		delay, retry := policy.retryDelay(get, 1, errors.New("connection reset by peer"))
+		assert.True(t, retry)
+		assert.True(t, delay >= 50*time.Millisecond && delay <= 100*time.Millisecond)
+
+		delay, retry = policy.retryDelay(get, 2, &APIError{StatusCode: 502})
+		assert.True(t, retry)
+		assert.True(t, delay >= 100*time.Millisecond && delay <= 200*time.Millisecond)
+	})
+
+	t.Run("It should stop after the max attempts", func(t *testing.T) {
+		_, retry := policy.retryDelay(get, 3, &APIError{StatusCode: 502})
+		assert.False(t, retry)
+	})
+
+	t.Run("It should not retry client errors, bans or cancelled requests", func(t *testing.T) {
+		_, retry := policy.retryDelay(get, 1, &APIError{StatusCode: 400, Code: -1021})
+		assert.False(t, retry)
+
+		_, retry = policy.retryDelay(get, 1, &APIError{StatusCode: 418, Code: -1003})
+		assert.False(t, retry)
+
+		_, retry = policy.retryDelay(get, 1, context.Canceled)
+		assert.False(t, retry)
+
+		_, retry = policy.retryDelay(get, 1, ErrRateLimitExceeded)
+		assert.False(t, retry)
+	})
+
+	t.Run("It should wait the Retry-After time of 429 responses", func(t *testing.T) {
+		delay, retry := policy.retryDelay(get, 1, &APIError{StatusCode: 429, RetryAfter: 3 * time.Second})
+		assert.True(t, retry)
+		assert.Equal(t, 3*time.Second, delay)
+	})
+
+	t.Run("It should not retry orders that could have been placed", func(t *testing.T) {
+		unsafePolicy := policy
+		unsafePolicy.RetryUnsafe = true
+
+		_, retry := unsafePolicy.retryDelay(newRequest("POST", "/api/v3/order"), 1, &APIError{StatusCode: 503})
+		assert.False(t, retry)
+
+		_, retry = unsafePolicy.retryDelay(newRequest("POST", "/api/v3/order").Param("newClientOrderId", "id"), 1, &APIError{StatusCode: 503})
+		assert.False(t, retry)
+
+		_, retry = unsafePolicy.retryDelay(newRequest("POST", "/api/v3/orderList/oco").Param("listClientOrderId", "id"), 1, &APIError{StatusCode: 503})
+		assert.False(t, retry)
+
+		_, retry = unsafePolicy.retryDelay(newRequest("POST", "/api/v3/orderList/otoco"), 1, errors.New("connection reset by peer"))
+		assert.False(t, retry)
+	})
+
+	t.Run("It should retry orders that did not reach the api", func(t *testing.T) {
+		unreachable := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+		_, retry := policy.retryDelay(newRequest("POST", "/api/v3/order"), 1, unreachable)
+		assert.True(t, retry)
+
+		_, retry = policy.retryDelay(newRequest("POST", "/api/v3/orderList/oto"), 1, &APIError{StatusCode: 429})
+		assert.True(t, retry)
+	})
+
+	t.Run("It should retry unsafe requests only when it is allowed", func(t *testing.T) {
+		cancel := newRequest("DELETE", "/api/v3/order")
+
+		_, retry := policy.retryDelay(cancel, 1, &APIError{StatusCode: 503})
+		assert.False(t, retry)
+
+		unsafePolicy := policy
+		unsafePolicy.RetryUnsafe = true
+		_, retry = unsafePolicy.retryDelay(cancel, 1, &APIError{StatusCode: 503})
+		assert.True(t, retry)
+	})
+}
@@ -1,21 +1,25 @@
 package binance
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"context"
+	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
 type Sdk struct {
-	client Client
-	clock  Clock
+	environment Environment
+	client      Client
+	clock       Clock
+	limiter     *RateLimiter
+	retryPolicy *RetryPolicy
 }
 
 type Clock interface {
@@ -31,27 +35,40 @@ func (t clock) Now() *int64 {
 }
 
 type Client interface {
-	Do(request *request) ([]byte, error)
+	Do(ctx context.Context, request *request) ([]byte, error)
 }
 
 type client struct {
-	baseUrl   string
-	apiKey    string
-	apiSecret string
+	baseUrls    []string
+	current     int32
+	apiKey      string
+	signer      Signer
+	httpClient  *http.Client
+	userAgent   string
+	limiter     *RateLimiter
+	retryPolicy *RetryPolicy
+	clock       Clock
 }
 
 type request struct {
 	method     string
 	path       string
-	parameters url.Values
+	parameters parameterList
 	signed     bool
 }
 
+// Parameters of a request in the order they were added, which is the order they are sent and signed in.
+type parameterList []parameter
+
+type parameter struct {
+	key   string
+	value string
+}
+
 func newRequest(method string, path string) *request {
 	return &request{
-		method:     method,
-		path:       path,
-		parameters: url.Values{},
+		method: method,
+		path:   path,
 	}
 }
 
@@ -67,9 +84,9 @@ func (r *request) StringParam(key string, value *string) *request {
 	return r
 }
 
-func (r *request) Float64Param(key string, value *float64) *request {
+func (r *request) DecimalParam(key string, value *Decimal) *request {
 	if value != nil {
-		r.parameters.Set(key, strconv.FormatFloat(*value, 'f', 8, 64))
+		r.parameters.Set(key, value.String())
 	}
 	return r
 }
@@ -81,68 +98,239 @@ func (r *request) Int64Param(key string, value *int64) *request {
 	return r
 }
 
+// Sends the values as a json array, like ["BTCUSDT","BNBBTC"]. Nothing is sent when there are no values.
+func (r *request) StringsParam(key string, values []string) *request {
+	if len(values) > 0 {
+		encoded, _ := json.Marshal(values)
+		r.parameters.Set(key, string(encoded))
+	}
+	return r
+}
+
 func (r *request) Sign() *request {
 	r.signed = true
 	return r
 }
 
-func (c *client) Do(request *request) ([]byte, error) {
-	r, _ := c.createRequest(request)
-	c.addSignature(request, r)
+func (c *client) Do(ctx context.Context, request *request) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := c.send(ctx, request)
+		if clock, ok := c.clock.(*ServerClock); ok && errors.Is(err, ErrTimestampOutsideRecvWindow) {
+			clock.Invalidate()
+		}
+		if err == nil || c.retryPolicy == nil {
+			return response, err
+		}
+
+		delay, retry := c.retryPolicy.retryDelay(request, attempt, err)
+		if !retry {
+			return nil, err
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *client) send(ctx context.Context, request *request) ([]byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.reserve(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
+	for failovers := 0; ; failovers++ {
+		baseUrl := c.baseUrl()
+		response, err := c.roundTrip(ctx, request, baseUrl)
+		if err == nil || !isUnreachable(err) || failovers >= len(c.baseUrls)-1 {
+			return response, err
+		}
+
+		c.failover(baseUrl)
+	}
+}
+
+func (c *client) roundTrip(ctx context.Context, request *request, baseUrl string) ([]byte, error) {
+	r, err := c.createRequest(ctx, request, baseUrl)
+	if err != nil {
+		return nil, err
+	}
+
 	r.Header.Set("X-MBX-APIKEY", c.apiKey)
+	if c.userAgent != "" {
+		r.Header.Set("User-Agent", c.userAgent)
+	}
 
-	client := http.Client{}
-	response, err := client.Do(r)
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
 
+	response, err := httpClient.Do(r)
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
+
+	if c.limiter != nil {
+		c.limiter.update(response.Header)
+	}
 
 	responseBody, _ := ioutil.ReadAll(response.Body)
 	if response.StatusCode >= 300 {
-		return nil, errors.New("Error " + string(response.StatusCode) + ": " + string(responseBody))
+		apiError := newAPIError(response.StatusCode, responseBody)
+		apiError.RetryAfter = parseRetryAfter(response.Header.Get("Retry-After"))
+		if c.limiter != nil && (response.StatusCode == 429 || response.StatusCode == 418) {
+			c.limiter.block(apiError)
+		}
+		return nil, apiError
 	}
 	return responseBody, nil
 }
 
-func (c *client) createRequest(request *request) (*http.Request, error) {
-	if request.method == "GET" {
-		return c.get(request)
+// Base url of the environment in use.
+func (c *client) baseUrl() string {
+	return c.baseUrls[atomic.LoadInt32(&c.current)]
+}
+
+// Switches to the next base url unless another request has switched it already.
+func (c *client) failover(failedBaseUrl string) {
+	current := atomic.LoadInt32(&c.current)
+	if c.baseUrls[current] == failedBaseUrl {
+		atomic.CompareAndSwapInt32(&c.current, current, (current+1)%int32(len(c.baseUrls)))
+	}
+}
+
+// Reports whether the connection could not be established, so the request has not been sent.
+func isUnreachable(err error) bool {
+	opError := &net.OpError{}
+	return errors.As(err, &opError) && opError.Op == "dial"
+}
+
+// Value of the given key, or an empty string when it has not been added.
+func (p parameterList) Get(key string) string {
+	for _, parameter := range p {
+		if parameter.key == key {
+			return parameter.value
+		}
+	}
+	return ""
+}
+
+// Replaces the value of the given key, keeping its position, or adds it at the end.
+func (p *parameterList) Set(key string, value string) {
+	for i := range *p {
+		if (*p)[i].key == key {
+			(*p)[i].value = value
+			return
+		}
 	}
-	return c.post(request)
+	*p = append(*p, parameter{key: key, value: value})
 }
 
-func (c *client) sign(request *request) string {
-	signature := hmac.New(sha256.New, []byte(c.apiSecret))
-	signature.Write([]byte(request.parameters.Encode()))
+// Url encoded form of the parameters, like symbol=BTCUSDT&side=BUY.
+func (p parameterList) Encode() string {
+	encoded := make([]string, 0, len(p))
+	for _, parameter := range p {
+		encoded = append(encoded, url.QueryEscape(parameter.key)+"="+url.QueryEscape(parameter.value))
+	}
+	return strings.Join(encoded, "&")
+}
+
+// Creates the http request sending the parameters in the query string of GET requests and in a form body for POST,
+// PUT and DELETE requests.
+//
+// The parameters are encoded only once, in the order they were added, and the signature is calculated over exactly
+// those bytes, so the signature always matches what is sent.
+func (c *client) createRequest(ctx context.Context, request *request, baseUrl string) (*http.Request, error) {
+	payload, err := c.encodeParameters(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.method == "POST" || request.method == "PUT" || request.method == "DELETE" {
+		httpRequest, err := http.NewRequestWithContext(ctx, request.method, baseUrl+request.path, strings.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+
+		httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return httpRequest, nil
+	}
 
-	return hex.EncodeToString(signature.Sum(nil))
+	requestUrl := baseUrl + request.path
+	if payload != "" {
+		requestUrl += "?" + payload
+	}
+	return http.NewRequestWithContext(ctx, request.method, requestUrl, nil)
 }
 
-func (c *client) addSignature(request *request, httpRequest *http.Request) {
-	if request.signed {
-		query := httpRequest.URL.Query()
-		httpRequest.URL.RawQuery = query.Encode() + "&signature=" + c.sign(request)
+// The timestamp of signed requests is taken again from the clock, so retries and requests that waited for the rate
+// limiter are not rejected for being outside of the recvWindow.
+func (c *client) encodeParameters(request *request) (string, error) {
+	if !request.signed {
+		return request.parameters.Encode(), nil
+	}
+
+	parameters := request.parameters
+	if c.clock != nil && parameters.Get("timestamp") != "" {
+		parameters = append(parameterList{}, request.parameters...)
+		parameters.Set("timestamp", strconv.FormatInt(*c.clock.Now(), 10))
+	}
+
+	payload := parameters.Encode()
+
+	signature, err := c.signer.Sign([]byte(payload))
+	if err != nil {
+		return "", err
 	}
+
+	if payload != "" {
+		payload += "&"
+	}
+	return payload + "signature=" + url.QueryEscape(signature), nil
+}
+
+// Environment the sdk connects to.
+func (sdk Sdk) Environment() Environment {
+	return sdk.environment
 }
 
-func (c *client) get(request *request) (*http.Request, error) {
-	parameters := request.parameters.Encode()
-	return http.NewRequest(request.method, c.baseUrl+request.path + "?" + parameters, nil)
+// Rate limiter shared by all the requests of the sdk. It is nil when the sdk is not created with New.
+func (sdk Sdk) RateLimiter() *RateLimiter {
+	return sdk.limiter
 }
 
-func (c *client) post(request *request) (*http.Request, error) {
-	form := request.parameters.Encode()
-	return http.NewRequest(request.method, c.baseUrl+request.path, strings.NewReader(form))
+// Retry policy used by all the requests of the sdk. Change it before sharing the sdk between goroutines. It is nil
+// when the sdk is not created with New.
+func (sdk Sdk) RetryPolicy() *RetryPolicy {
+	return sdk.retryPolicy
 }
 
-func New(apiKey string, apiSecret string) Sdk {
-	return Sdk{
-		client: &client{
-			baseUrl:   "https://api.binance.com",
-			apiKey:    apiKey,
-			apiSecret: apiSecret,
-		},
-		clock: clock{},
+func New(apiKey string, apiSecret string, configuration ...Option) Sdk {
+	options := newOptions(apiSecret, configuration)
+	httpClient := &client{
+		baseUrls:    options.environment.RestURLs,
+		apiKey:      apiKey,
+		signer:      options.signer,
+		httpClient:  options.buildHTTPClient(),
+		userAgent:   options.userAgent,
+		limiter:     options.limiter,
+		retryPolicy: &options.retryPolicy,
+	}
+	sdk := Sdk{
+		environment: options.environment,
+		client:      httpClient,
+		clock:       options.clock,
+		limiter:     options.limiter,
+		retryPolicy: &options.retryPolicy,
 	}
+
+	if sdk.clock == nil {
+		sdk.clock = NewServerClock(sdk, DefaultSyncInterval)
+	}
+	httpClient.clock = sdk.clock
+
+	return sdk
 }
@@ -1,10 +1,14 @@
 package binance
 
 import (
+	"context"
+	"errors"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func runLocalServer() (server *httptest.Server, mux *http.ServeMux) {
@@ -14,14 +18,25 @@ func runLocalServer() (server *httptest.Server, mux *http.ServeMux) {
 	return srv, mux
 }
 
+// Clock that returns the next millisecond every time.
+type sequenceClock struct {
+	next int64
+}
+
+func (c *sequenceClock) Now() *int64 {
+	now := c.next
+	c.next++
+	return &now
+}
+
 func TestClient_Do(t *testing.T) {
 	server, mux := runLocalServer()
 	defer server.Close()
 
 	sdk := client{
-		baseUrl:   server.URL,
-		apiKey:    "key",
-		apiSecret: "secret",
+		baseUrls: []string{server.URL},
+		apiKey:   "key",
+		signer:   NewHMACSigner("secret"),
 	}
 
 	t.Run("It should return the server response", func(t *testing.T) {
@@ -31,20 +46,174 @@ func TestClient_Do(t *testing.T) {
 			w.WriteHeader(200)
 		})
 
-		response, _ := sdk.Do(newRequest("GET", "/testing"))
+		response, _ := sdk.Do(context.Background(), newRequest("GET", "/testing"))
 		assert.Equal(t, expectedSuccessResponse, response)
 	})
 
 	t.Run("It should return an error on receive an invalid path", func(t *testing.T) {
-		_, err := sdk.Do(newRequest("GET", "wrong-path"))
+		_, err := sdk.Do(context.Background(), newRequest("GET", "wrong-path"))
 		assert.Error(t, err)
 	})
 
 	t.Run("It should return an error on server error", func(t *testing.T) {
-		_, err := sdk.Do(newRequest("GET", "/missing-path"))
+		_, err := sdk.Do(context.Background(), newRequest("GET", "/missing-path"))
 		assert.Error(t, err)
 	})
 
+	t.Run("It should return an api error with the binance error code", func(t *testing.T) {
+		mux.HandleFunc("/testing-error", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(400)
+			w.Write([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`))
+		})
+
+		_, err := sdk.Do(context.Background(), newRequest("GET", "/testing-error"))
+
+		apiError := &APIError{}
+		assert.True(t, errors.As(err, &apiError))
+		assert.Equal(t, 400, apiError.StatusCode)
+		assert.True(t, errors.Is(err, ErrTimestampOutsideRecvWindow))
+	})
+
+	t.Run("It should update the rate limiter with the response headers", func(t *testing.T) {
+		mux.HandleFunc("/testing-weight", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-MBX-USED-WEIGHT-1M", "42")
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		limitedClient := sdk
+		limitedClient.limiter = NewRateLimiter()
+		limitedClient.Do(context.Background(), newRequest("GET", "/testing-weight"))
+
+		assert.Equal(t, 42, limitedClient.limiter.Usage()[0].Used)
+	})
+
+	t.Run("It should not send the request when the rate limiter fails", func(t *testing.T) {
+		limitedClient := sdk
+		limitedClient.limiter = NewRateLimiter().FailFast(true)
+		limitedClient.limiter.SetLimits([]RateLimits{{RateLimitType: RateLimitRequestWeight, Interval: "MINUTE", Limit: 1}})
+		limitedClient.Do(context.Background(), newRequest("GET", "/testing-weight"))
+
+		_, err := limitedClient.Do(context.Background(), newRequest("GET", "/testing-weight"))
+		assert.Equal(t, ErrRateLimitExceeded, err)
+	})
+
+	t.Run("It should retry failed requests with the retry policy", func(t *testing.T) {
+		attempts := 0
+		mux.HandleFunc("/testing-retry", func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(503)
+				return
+			}
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		retryingClient := sdk
+		retryingClient.retryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		response, err := retryingClient.Do(context.Background(), newRequest("GET", "/testing-retry"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"json":true}`), response)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("It should sign every attempt with a new timestamp", func(t *testing.T) {
+		timestamps := make([]string, 0)
+		mux.HandleFunc("/testing-retry-signed", func(w http.ResponseWriter, r *http.Request) {
+			timestamp := r.URL.Query().Get("timestamp")
+			signature, _ := NewHMACSigner("secret").Sign([]byte("symbol=LTCBTC&timestamp=" + timestamp))
+			assert.Equal(t, signature, r.URL.Query().Get("signature"))
+
+			timestamps = append(timestamps, timestamp)
+			if len(timestamps) < 2 {
+				w.WriteHeader(503)
+				return
+			}
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		retryingClient := sdk
+		retryingClient.clock = &sequenceClock{next: 1499827319559}
+		retryingClient.retryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		request := newRequest("GET", "/testing-retry-signed").
+			Param("symbol", "LTCBTC").
+			Param("timestamp", "1").
+			Sign()
+		_, err := retryingClient.Do(context.Background(), request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1499827319559", "1499827319560"}, timestamps)
+		assert.Equal(t, "1", request.parameters.Get("timestamp"))
+	})
+
+	t.Run("It should not retry when the ip is banned", func(t *testing.T) {
+		attempts := 0
+		mux.HandleFunc("/testing-banned", func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(418)
+			w.Write([]byte(`{"code":-1003,"msg":"Way too much request weight used; IP banned until 1659146400000."}`))
+		})
+
+		retryingClient := sdk
+		retryingClient.retryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+		_, err := retryingClient.Do(context.Background(), newRequest("GET", "/testing-banned"))
+
+		apiError := &APIError{}
+		assert.True(t, errors.As(err, &apiError))
+		assert.Equal(t, 2*time.Minute, apiError.RetryAfter)
+		assert.Equal(t, int64(1659146400000), apiError.BannedUntil.UnixNano()/int64(time.Millisecond))
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("It should hold the next requests back after a 429 response", func(t *testing.T) {
+		mux.HandleFunc("/testing-too-many-requests", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(429)
+			w.Write([]byte(`{"code":-1003,"msg":"Too much request weight used."}`))
+		})
+
+		limitedClient := sdk
+		limitedClient.limiter = NewRateLimiter().FailFast(true)
+		_, err := limitedClient.Do(context.Background(), newRequest("GET", "/testing-too-many-requests"))
+		assert.True(t, errors.Is(err, ErrTooManyRequests))
+
+		_, err = limitedClient.Do(context.Background(), newRequest("GET", "/testing-too-many-requests"))
+		assert.Equal(t, ErrRateLimitExceeded, err)
+	})
+
+	t.Run("It should synchronise the server clock again when the timestamp is outside the receive window", func(t *testing.T) {
+		mux.HandleFunc("/testing-recv-window", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(400)
+			w.Write([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`))
+		})
+
+		serverClock := &ServerClock{localTime: time.Now, synced: true, nextSync: time.Now().Add(time.Hour)}
+		resyncingClient := sdk
+		resyncingClient.clock = serverClock
+		_, err := resyncingClient.Do(context.Background(), newRequest("GET", "/testing-recv-window"))
+
+		assert.True(t, errors.Is(err, ErrTimestampOutsideRecvWindow))
+		assert.False(t, serverClock.synced)
+		assert.True(t, serverClock.nextSync.IsZero())
+	})
+
+	t.Run("It should fail over to the next base url when the current one is unreachable", func(t *testing.T) {
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+
+		mux.HandleFunc("/testing-failover", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		failoverClient := client{baseUrls: []string{unreachable.URL, server.URL}}
+		response, err := failoverClient.Do(context.Background(), newRequest("GET", "/testing-failover"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"json":true}`), response)
+		assert.Equal(t, server.URL, failoverClient.baseUrl())
+	})
+
 	t.Run("It should be signed", func(t *testing.T) {
 		expectedSuccessResponse := []byte(`{"json":true}`)
 		mux.HandleFunc("/testing-post", func(w http.ResponseWriter, r *http.Request) {
@@ -53,14 +222,85 @@ func TestClient_Do(t *testing.T) {
 		})
 
 		request := newRequest("POST", "/testing-post").Sign()
-		response, _ := sdk.Do(request)
+		response, _ := sdk.Do(context.Background(), request)
 		assert.Equal(t, expectedSuccessResponse, response)
 	})
+
+	t.Run("It should send the signed parameters of POST requests in a form body in the order they were added", func(t *testing.T) {
+		mux.HandleFunc("/testing-form", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			signature, _ := NewHMACSigner("secret").Sign([]byte("symbol=LTCBTC&quantity=1.5&timestamp=1499827319559"))
+
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+			assert.Empty(t, r.URL.RawQuery)
+			assert.Equal(t, "symbol=LTCBTC&quantity=1.5&timestamp=1499827319559&signature="+signature, string(body))
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		request := newRequest("POST", "/testing-form").
+			Param("symbol", "LTCBTC").
+			Param("quantity", "1.5").
+			Param("timestamp", "1499827319559").
+			Sign()
+		_, err := sdk.Do(context.Background(), request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("It should send the signed parameters of DELETE requests in a form body", func(t *testing.T) {
+		mux.HandleFunc("/testing-delete", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			signature, _ := NewHMACSigner("secret").Sign([]byte("symbol=LTCBTC&orderId=1"))
+
+			assert.Equal(t, "DELETE", r.Method)
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+			assert.Empty(t, r.URL.RawQuery)
+			assert.Equal(t, "symbol=LTCBTC&orderId=1&signature="+signature, string(body))
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		request := newRequest("DELETE", "/testing-delete").Param("symbol", "LTCBTC").Param("orderId", "1").Sign()
+		_, err := sdk.Do(context.Background(), request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("It should send the signature url encoded", func(t *testing.T) {
+		mux.HandleFunc("/testing-signer", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "a+b/c=", r.URL.Query().Get("signature"))
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		signedClient := sdk
+		signedClient.signer = fixedSigner("a+b/c=")
+		_, err := signedClient.Do(context.Background(), newRequest("GET", "/testing-signer").Param("symbol", "LTCBTC").Sign())
+		assert.NoError(t, err)
+	})
+
+	t.Run("It should return an error when the context is cancelled", func(t *testing.T) {
+		mux.HandleFunc("/testing-cancelled", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"json":true}`))
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := sdk.Do(ctx, newRequest("GET", "/testing-cancelled"))
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+type fixedSigner string
+
+func (s fixedSigner) Sign(payload []byte) (string, error) {
+	return string(s), nil
 }
 
 func TestNew(t *testing.T) {
 	sdk := New("api-key", "api-secret")
 	assert.Implements(t, (*Client)(nil), sdk.client)
+	assert.IsType(t, &ServerClock{}, sdk.clock)
+	assert.Same(t, sdk.clock, sdk.client.(*client).clock)
+	assert.NotNil(t, sdk.RateLimiter())
+	assert.Equal(t, DefaultRetryPolicy(), *sdk.RetryPolicy())
 }
 
 func TestClock_Now(t *testing.T) {
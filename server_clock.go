@@ -0,0 +1,131 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Interval used by New to synchronise the clock with the server time.
+const DefaultSyncInterval = 10 * time.Minute
+
+// Delay before trying again when a synchronisation fails.
+const syncRetryDelay = 10 * time.Second
+
+// Maximum time a synchronisation waits for the server time.
+const syncTimeout = 10 * time.Second
+
+var errInvalidServerTime = errors.New("invalid server time")
+
+// Clock that follows the Binance server time.
+//
+// The offset with the local time is measured with the ServerTime endpoint, compensating half of the round trip
+// latency. The first call to Now waits for the first synchronisation, syncTimeout at most, and returns the local
+// time when it fails. When the interval has passed, Now synchronises the clock again in the background and keeps
+// using the last offset meanwhile, so it only waits for the server until an offset has been measured.
+type ServerClock struct {
+	mutex      sync.Mutex
+	serverTime func(ctx context.Context) (*ServerTime, error)
+	localTime  func() time.Time
+	interval   time.Duration
+	offset     time.Duration
+	latency    time.Duration
+	nextSync   time.Time
+	synced     bool
+	syncDone   chan struct{}
+}
+
+// Returns a clock synchronised with the server time of the given sdk every interval.
+func NewServerClock(sdk Sdk, interval time.Duration) *ServerClock {
+	return &ServerClock{
+		serverTime: sdk.ServerTimeCtx,
+		localTime:  time.Now,
+		interval:   interval,
+	}
+}
+
+// Current server time in milliseconds.
+func (c *ServerClock) Now() *int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.syncDone == nil && !c.localTime().Before(c.nextSync) {
+		c.syncDone = make(chan struct{})
+		go c.syncInBackground(c.syncDone)
+	}
+
+	if done := c.syncDone; !c.synced && done != nil {
+		c.mutex.Unlock()
+		<-done
+		c.mutex.Lock()
+	}
+
+	now := c.localTime().Add(c.offset).UnixNano() / int64(time.Millisecond)
+	return &now
+}
+
+// Synchronises the clock with the server time right now. It waits syncTimeout at most.
+func (c *ServerClock) Sync(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+
+	sentAt := c.localTime()
+	response, err := c.serverTime(ctx)
+	receivedAt := c.localTime()
+	if err == nil && (response == nil || response.ServerTime <= 0) {
+		err = errInvalidServerTime
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err != nil {
+		c.nextSync = receivedAt.Add(syncRetryDelay)
+		return err
+	}
+
+	c.synced = true
+	c.latency = receivedAt.Sub(sentAt)
+	serverTime := time.Unix(0, response.ServerTime*int64(time.Millisecond))
+	c.offset = serverTime.Sub(sentAt.Add(c.latency / 2))
+	c.nextSync = receivedAt.Add(c.interval)
+
+	return nil
+}
+
+// Makes the next call to Now wait for a new synchronisation. The sdk calls it when Binance rejects the timestamp
+// of a request because it is outside the receive window.
+func (c *ServerClock) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.synced = false
+	c.nextSync = time.Time{}
+}
+
+// Difference between the server and the local time measured in the last synchronisation.
+func (c *ServerClock) Offset() time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.offset
+}
+
+// Round trip latency of the last synchronisation.
+func (c *ServerClock) Latency() time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.latency
+}
+
+func (c *ServerClock) syncInBackground(done chan struct{}) {
+	c.Sync(context.Background())
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.syncDone = nil
+	close(done)
+}
@@ -0,0 +1,249 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeLocalTime struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (f *fakeLocalTime) Now() time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.now
+}
+
+func (f *fakeLocalTime) Advance(duration time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.now = f.now.Add(duration)
+}
+
+// Waits until the synchronisation started by Now has finished.
+func waitForSync(t *testing.T, clock *ServerClock) {
+	assert.Eventually(t, func() bool {
+		clock.mutex.Lock()
+		defer clock.mutex.Unlock()
+
+		return clock.syncDone == nil
+	}, time.Second, time.Millisecond)
+}
+
+func TestServerClock_Now(t *testing.T) {
+	t.Run("It should correct the local time with the server offset and half the latency", func(t *testing.T) {
+		local := &fakeLocalTime{now: time.Unix(1000, 0)}
+		clock := &ServerClock{
+			localTime: local.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				local.Advance(200 * time.Millisecond)
+				return &ServerTime{ServerTime: 1005100}, nil
+			},
+		}
+
+		clock.Now()
+		waitForSync(t, clock)
+
+		assert.Equal(t, int64(1005200), *clock.Now())
+		assert.Equal(t, 5*time.Second, clock.Offset())
+		assert.Equal(t, 200*time.Millisecond, clock.Latency())
+	})
+
+	t.Run("It should synchronise again only when the interval has passed", func(t *testing.T) {
+		local := &fakeLocalTime{now: time.Unix(1000, 0)}
+		calls := 0
+		clock := &ServerClock{
+			localTime: local.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				calls++
+				return &ServerTime{ServerTime: local.Now().UnixNano()/int64(time.Millisecond) + int64(calls)}, nil
+			},
+		}
+
+		clock.Now()
+		waitForSync(t, clock)
+		local.Advance(30 * time.Second)
+		clock.Now()
+		waitForSync(t, clock)
+		assert.Equal(t, 1, calls)
+
+		local.Advance(30 * time.Second)
+		clock.Now()
+		waitForSync(t, clock)
+		assert.Equal(t, int64(1060002), *clock.Now())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("It should wait for the first synchronisation", func(t *testing.T) {
+		local := &fakeLocalTime{now: time.Unix(1000, 0)}
+		clock := &ServerClock{
+			localTime: local.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				time.Sleep(10 * time.Millisecond)
+				return &ServerTime{ServerTime: 1005000}, nil
+			},
+		}
+
+		results := make(chan int64, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				results <- *clock.Now()
+			}()
+		}
+
+		assert.Equal(t, int64(1005000), <-results)
+		assert.Equal(t, int64(1005000), <-results)
+	})
+
+	t.Run("It should wait for a new synchronisation after an invalidation", func(t *testing.T) {
+		local := &fakeLocalTime{now: time.Unix(1000, 0)}
+		calls := 0
+		clock := &ServerClock{
+			localTime: local.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				calls++
+				return &ServerTime{ServerTime: 1000000 + int64(calls)*1000}, nil
+			},
+		}
+
+		assert.Equal(t, int64(1001000), *clock.Now())
+		clock.Invalidate()
+		assert.Equal(t, int64(1002000), *clock.Now())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("It should fallback to the local time when the synchronisation fails", func(t *testing.T) {
+		local := &fakeLocalTime{now: time.Unix(1000, 0)}
+		calls := 0
+		clock := &ServerClock{
+			localTime: local.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				calls++
+				return nil, errors.New("error")
+			},
+		}
+
+		assert.Equal(t, int64(1000000), *clock.Now())
+		waitForSync(t, clock)
+		local.Advance(time.Second)
+		clock.Now()
+		waitForSync(t, clock)
+		assert.Equal(t, 1, calls)
+
+		local.Advance(syncRetryDelay)
+		clock.Now()
+		waitForSync(t, clock)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("It should not wait for a slow server time while it synchronises", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		clock := &ServerClock{
+			localTime: time.Now,
+			interval:  time.Minute,
+			synced:    true,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				<-release
+				return nil, ctx.Err()
+			},
+		}
+
+		startedAt := time.Now()
+		clock.Now()
+		clock.Now()
+		assert.True(t, time.Since(startedAt) < 100*time.Millisecond)
+	})
+
+	t.Run("It should not override the deadline of signed requests", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		ctrl := gomock.NewController(t)
+		mockedClient := NewMockClient(ctrl)
+		clock := &ServerClock{
+			localTime: time.Now,
+			interval:  time.Minute,
+			synced:    true,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				<-release
+				return nil, ctx.Err()
+			},
+		}
+		sdk := Sdk{client: mockedClient, clock: clock}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, request *request) ([]byte, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		startedAt := time.Now()
+		_, err := sdk.AccountCtx(ctx, NewAccountQuery())
+
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.True(t, time.Since(startedAt) < time.Second)
+	})
+}
+
+func TestServerClock_Sync(t *testing.T) {
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		clock := NewServerClock(Sdk{client: mockedClient}, time.Minute)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest("GET", "/api/v3/time")).
+			Return(nil, errors.New("error"))
+
+		assert.Error(t, clock.Sync(context.Background()))
+	})
+
+	t.Run("It should reject a response without server time", func(t *testing.T) {
+		clock := &ServerClock{
+			localTime: time.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				return &ServerTime{}, nil
+			},
+		}
+
+		assert.Equal(t, errInvalidServerTime, clock.Sync(context.Background()))
+		assert.Equal(t, time.Duration(0), clock.Offset())
+	})
+
+	t.Run("It should limit the time waiting for the server", func(t *testing.T) {
+		clock := &ServerClock{
+			localTime: time.Now,
+			interval:  time.Minute,
+			serverTime: func(ctx context.Context) (*ServerTime, error) {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.True(t, time.Until(deadline) <= syncTimeout)
+				return nil, errors.New("error")
+			},
+		}
+
+		clock.Sync(context.Background())
+	})
+}
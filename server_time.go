@@ -0,0 +1,32 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type ServerTime struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+// Current server time in milliseconds.
+func (sdk Sdk) ServerTime() (*ServerTime, error) {
+	return sdk.ServerTimeCtx(context.Background())
+}
+
+// Current server time in milliseconds, cancelled when the given context is done.
+func (sdk Sdk) ServerTimeCtx(ctx context.Context) (*ServerTime, error) {
+	request := newRequest("GET", "/api/v3/time")
+	response, err := sdk.client.Do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseServerTimeResponse(response)
+}
+
+func parseServerTimeResponse(jsonContent []byte) (*ServerTime, error) {
+	response := &ServerTime{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
@@ -0,0 +1,57 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSdk_ServerTime(t *testing.T) {
+	method, url := "GET", "/api/v3/time"
+
+	t.Run("It should convert api response to a ServerTime", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return([]byte(`{"serverTime": 1499827319559}`), nil)
+
+		response, _ := sdk.ServerTime()
+
+		assert.Equal(t, &ServerTime{ServerTime: 1499827319559}, response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.ServerTime()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.ServerTime()
+
+		assert.Error(t, err)
+	})
+}
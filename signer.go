@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+)
+
+// Signs the payload of the requests to the SIGNED endpoints.
+type Signer interface {
+	Sign(payload []byte) (string, error)
+}
+
+type hmacSigner struct {
+	secret []byte
+}
+
+// Returns a signer for HMAC api keys, which uses the api secret as the HMAC-SHA256 key.
+func NewHMACSigner(apiSecret string) Signer {
+	return &hmacSigner{secret: []byte(apiSecret)}
+}
+
+func (s *hmacSigner) Sign(payload []byte) (string, error) {
+	signature := hmac.New(sha256.New, s.secret)
+	signature.Write(payload)
+
+	return hex.EncodeToString(signature.Sum(nil)), nil
+}
+
+type rsaSigner struct {
+	key *rsa.PrivateKey
+}
+
+// Returns a signer for RSA api keys, which signs with RSASSA-PKCS1-v1_5 and SHA-256.
+func NewRSASigner(key *rsa.PrivateKey) Signer {
+	return &rsaSigner{key: key}
+}
+
+// Returns a signer for RSA api keys from a PEM encoded PKCS#1 or PKCS#8 private key.
+func NewRSASignerFromPEM(pemContent []byte) (Signer, error) {
+	key, err := parsePEMPrivateKey(pemContent)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key is not a RSA key")
+	}
+	return NewRSASigner(rsaKey), nil
+}
+
+func (s *rsaSigner) Sign(payload []byte) (string, error) {
+	hashed := sha256.Sum256(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// Returns a signer for Ed25519 api keys.
+func NewEd25519Signer(key ed25519.PrivateKey) Signer {
+	return &ed25519Signer{key: key}
+}
+
+// Returns a signer for Ed25519 api keys from a PEM encoded PKCS#8 private key.
+func NewEd25519SignerFromPEM(pemContent []byte) (Signer, error) {
+	key, err := parsePEMPrivateKey(pemContent)
+	if err != nil {
+		return nil, err
+	}
+
+	ed25519Key, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key is not an Ed25519 key")
+	}
+	return NewEd25519Signer(ed25519Key), nil
+}
+
+func (s *ed25519Signer) Sign(payload []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)), nil
+}
+
+func parsePEMPrivateKey(pemContent []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(pemContent)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	return x509.ParsePKCS8PrivateKey(block.Bytes)
+}
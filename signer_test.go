@@ -0,0 +1,80 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func signedPayload() []byte {
+	return []byte("symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559")
+}
+
+func TestHMACSigner_Sign(t *testing.T) {
+	signer := NewHMACSigner("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+
+	signature, err := signer.Sign(signedPayload())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71", signature)
+}
+
+func TestRSASigner_Sign(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	t.Run("It should sign with PKCS#1 v1.5 and SHA-256", func(t *testing.T) {
+		signer, err := NewRSASignerFromPEM(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		assert.NoError(t, err)
+
+		signature, err := signer.Sign(signedPayload())
+		assert.NoError(t, err)
+
+		decoded, _ := base64.StdEncoding.DecodeString(signature)
+		hashed := sha256.Sum256(signedPayload())
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], decoded))
+	})
+
+	t.Run("It should load PKCS#8 keys", func(t *testing.T) {
+		pkcs8, _ := x509.MarshalPKCS8PrivateKey(key)
+		_, err := NewRSASignerFromPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("It should return error when the key is not a RSA key", func(t *testing.T) {
+		_, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+		pkcs8, _ := x509.MarshalPKCS8PrivateKey(ed25519Key)
+		_, err := NewRSASignerFromPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEd25519Signer_Sign(t *testing.T) {
+	publicKey, key, _ := ed25519.GenerateKey(rand.Reader)
+
+	t.Run("It should sign with Ed25519", func(t *testing.T) {
+		pkcs8, _ := x509.MarshalPKCS8PrivateKey(key)
+		signer, err := NewEd25519SignerFromPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+		assert.NoError(t, err)
+
+		signature, err := signer.Sign(signedPayload())
+		assert.NoError(t, err)
+
+		decoded, _ := base64.StdEncoding.DecodeString(signature)
+		assert.True(t, ed25519.Verify(publicKey, signedPayload(), decoded))
+	})
+
+	t.Run("It should return error when there is no PEM data", func(t *testing.T) {
+		_, err := NewEd25519SignerFromPEM([]byte("not a key"))
+
+		assert.Error(t, err)
+	})
+}
@@ -1,15 +1,16 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
 type OrderBookTicker struct {
 	Symbol      string  `json:"symbol"`
-	BidPrice    float64 `json:"bidPrice,string"`
-	BidQuantity float64 `json:"bidQty,string"`
-	AskPrice    float64 `json:"askPrice,string"`
-	AskQuantity float64 `json:"askQty,string"`
+	BidPrice    Decimal `json:"bidPrice"`
+	BidQuantity Decimal `json:"bidQty"`
+	AskPrice    Decimal `json:"askPrice"`
+	AskQuantity Decimal `json:"askQty"`
 }
 
 type symbolOrderBookTickerQuery struct {
@@ -21,8 +22,12 @@ func NewSymbolOrderBookTickerQuery(symbol string) *symbolOrderBookTickerQuery {
 }
 
 func (sdk Sdk) SymbolOrderBookTicker(query *symbolOrderBookTickerQuery) (*OrderBookTicker, error) {
+	return sdk.SymbolOrderBookTickerCtx(context.Background(), query)
+}
+
+func (sdk Sdk) SymbolOrderBookTickerCtx(ctx context.Context, query *symbolOrderBookTickerQuery) (*OrderBookTicker, error) {
 	request := newRequest("GET", "/api/v3/ticker/bookTicker").Param("symbol", query.symbol)
-	response, err := sdk.client.Do(request)
+	response, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +36,12 @@ func (sdk Sdk) SymbolOrderBookTicker(query *symbolOrderBookTickerQuery) (*OrderB
 }
 
 func (sdk Sdk) AllSymbolOrderBookTickers() ([]OrderBookTicker, error) {
+	return sdk.AllSymbolOrderBookTickersCtx(context.Background())
+}
+
+func (sdk Sdk) AllSymbolOrderBookTickersCtx(ctx context.Context) ([]OrderBookTicker, error) {
 	request := newRequest("GET", "/api/v3/ticker/bookTicker")
-	response, err := sdk.client.Do(request)
+	response, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
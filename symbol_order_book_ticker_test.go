@@ -18,7 +18,7 @@ func TestSdk_SymbolOrderBookTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(valiSymbolOrderBookTickerJson(), nil)
 
@@ -35,7 +35,7 @@ func TestSdk_SymbolOrderBookTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -52,7 +52,7 @@ func TestSdk_SymbolOrderBookTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -73,7 +73,7 @@ func TestSdk_AllSymbolOrderBookTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validAllSymbolOrderBookTickersJson(), nil)
 
@@ -90,7 +90,7 @@ func TestSdk_AllSymbolOrderBookTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -107,7 +107,7 @@ func TestSdk_AllSymbolOrderBookTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -130,10 +130,10 @@ func valiSymbolOrderBookTickerJson() []byte {
 func validSymbolOrderBookTickerResponse() *OrderBookTicker {
 	return &OrderBookTicker{
 		Symbol:      "LTCBTC",
-		BidPrice:    4,
-		BidQuantity: 431,
-		AskPrice:    4.000002,
-		AskQuantity: 9,
+		BidPrice:    MustDecimal("4"),
+		BidQuantity: MustDecimal("431"),
+		AskPrice:    MustDecimal("4.000002"),
+		AskQuantity: MustDecimal("9"),
 	}
 }
 
@@ -160,17 +160,17 @@ func validAllSymbolOrderBookTickersResponse() []OrderBookTicker {
 	return []OrderBookTicker{
 		{
 			Symbol:      "LTCBTC",
-			BidPrice:    4,
-			BidQuantity: 431,
-			AskPrice:    4.000002,
-			AskQuantity: 9,
+			BidPrice:    MustDecimal("4"),
+			BidQuantity: MustDecimal("431"),
+			AskPrice:    MustDecimal("4.000002"),
+			AskQuantity: MustDecimal("9"),
 		},
 		{
 			Symbol:      "ETHBTC",
-			BidPrice:    0.079467,
-			BidQuantity: 9,
-			AskPrice:    100000,
-			AskQuantity: 1000,
+			BidPrice:    MustDecimal("0.079467"),
+			BidQuantity: MustDecimal("9"),
+			AskPrice:    MustDecimal("100000"),
+			AskQuantity: MustDecimal("1000"),
 		},
 	}
 }
@@ -1,13 +1,14 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 )
 
 // Symbol price ticker data transfer object (DTO)
 type SymbolPrice struct {
 	Symbol string
-	Price  float64 `json:"price,string"`
+	Price  Decimal `json:"price"`
 }
 
 type symbolPriceTickerQuery struct {
@@ -21,8 +22,13 @@ func NewSymbolPriceTickerQuery(symbol string) *symbolPriceTickerQuery {
 
 // Latest price for a symbol.
 func (sdk Sdk) SymbolPriceTicker(query *symbolPriceTickerQuery) (*SymbolPrice, error) {
+	return sdk.SymbolPriceTickerCtx(context.Background(), query)
+}
+
+// Latest price for a symbol, cancelled when the given context is done.
+func (sdk Sdk) SymbolPriceTickerCtx(ctx context.Context, query *symbolPriceTickerQuery) (*SymbolPrice, error) {
 	request := newRequest("GET", "/api/v3/ticker/price").Param("symbol", query.symbol)
-	response, err := sdk.client.Do(request)
+	response, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -32,8 +38,13 @@ func (sdk Sdk) SymbolPriceTicker(query *symbolPriceTickerQuery) (*SymbolPrice, e
 
 // Latest price for all symbols.
 func (sdk Sdk) AllSymbolPriceTickers() ([]SymbolPrice, error) {
+	return sdk.AllSymbolPriceTickersCtx(context.Background())
+}
+
+// Latest price for all symbols, cancelled when the given context is done.
+func (sdk Sdk) AllSymbolPriceTickersCtx(ctx context.Context) ([]SymbolPrice, error) {
 	request := newRequest("GET", "/api/v3/ticker/price")
-	response, err := sdk.client.Do(request)
+	response, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +18,7 @@ func TestSdk_SymbolPriceTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validSymbolPriceTickerJson(), nil)
 
@@ -35,7 +35,7 @@ func TestSdk_SymbolPriceTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -52,7 +52,7 @@ func TestSdk_SymbolPriceTicker(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -73,7 +73,7 @@ func TestSdk_AllSymbolPriceTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validSymbolPriceTickerSliceJson(), nil)
 
@@ -90,7 +90,7 @@ func TestSdk_AllSymbolPriceTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(nil, errors.New("error"))
 
@@ -107,7 +107,7 @@ func TestSdk_AllSymbolPriceTickers(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -127,7 +127,7 @@ func validSymbolPriceTickerJson() []byte {
 func validSymbolPriceTickerResponse() *SymbolPrice {
 	return &SymbolPrice{
 		Symbol: "LTCBTC",
-		Price:  4.000002,
+		Price:  MustDecimal("4.000002"),
 	}
 }
 
@@ -148,11 +148,11 @@ func validSymbolPriceTickerSliceResponse() []SymbolPrice {
 	return []SymbolPrice{
 		{
 			Symbol: "LTCBTC",
-			Price:  4.000002,
+			Price:  MustDecimal("4.000002"),
 		},
 		{
 			Symbol: "ETHBTC",
-			Price:  0.079466,
+			Price:  MustDecimal("0.079466"),
 		},
 	}
 }
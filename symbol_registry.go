@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Interval suggested to refresh a SymbolRegistry. The filters of the symbols rarely change.
+const DefaultRefreshInterval = time.Hour
+
+// Delay before trying again when a refresh fails.
+const refreshRetryDelay = 10 * time.Second
+
+// Maximum time a background refresh waits, including the wait for the rate limiter.
+const refreshTimeout = 2 * time.Minute
+
+// Returned when the registry has no symbol with the given name.
+var ErrUnknownSymbol = errors.New("unknown symbol")
+
+// Symbols of the exchange indexed by name and by asset, safe for concurrent use.
+//
+// Lookups never wait for the api: when the interval has passed or a filter failure has been reported with
+// ReportError, the exchange information is loaded again in the background and the lookups keep returning the last
+// symbols meanwhile. The registry is empty until the first load finishes, so call Refresh to load it up front and
+// get the error.
+type SymbolRegistry struct {
+	mutex        sync.RWMutex
+	exchangeInfo func(ctx context.Context) (*ExchangeInfo, error)
+	validator    func(ctx context.Context, symbol Symbol) (*OrderValidator, error)
+	localTime    func() time.Time
+	interval     time.Duration
+	nextRefresh  time.Time
+	refreshing   bool
+	symbols      []Symbol
+	bySymbol     map[string]Symbol
+	byBaseAsset  map[string][]Symbol
+	byQuoteAsset map[string][]Symbol
+}
+
+// Returns a registry with all the symbols of the exchange refreshed every interval.
+func NewSymbolRegistry(sdk Sdk, interval time.Duration) *SymbolRegistry {
+	return NewSymbolRegistryFor(sdk, NewExchangeInfoQuery(), interval)
+}
+
+// Returns a registry with the symbols selected by the query refreshed every interval.
+func NewSymbolRegistryFor(sdk Sdk, query *exchangeInfoQuery, interval time.Duration) *SymbolRegistry {
+	return &SymbolRegistry{
+		exchangeInfo: func(ctx context.Context) (*ExchangeInfo, error) {
+			return sdk.QueryExchangeInfoCtx(ctx, query)
+		},
+		validator: sdk.OrderValidatorCtx,
+		localTime: time.Now,
+		interval:  interval,
+	}
+}
+
+// Loads the exchange information right now, cancelled when the given context is done. The lookups are not blocked
+// while it waits for the api.
+func (r *SymbolRegistry) Refresh(ctx context.Context) error {
+	exchangeInfo, err := r.exchangeInfo(ctx)
+	receivedAt := r.localTime()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err != nil {
+		r.nextRefresh = receivedAt.Add(refreshRetryDelay)
+		return err
+	}
+
+	r.symbols = exchangeInfo.Symbols
+	r.bySymbol = make(map[string]Symbol, len(exchangeInfo.Symbols))
+	r.byBaseAsset = make(map[string][]Symbol)
+	r.byQuoteAsset = make(map[string][]Symbol)
+	for _, symbol := range exchangeInfo.Symbols {
+		r.bySymbol[symbol.Symbol] = symbol
+		r.byBaseAsset[symbol.BaseAsset] = append(r.byBaseAsset[symbol.BaseAsset], symbol)
+		r.byQuoteAsset[symbol.QuoteAsset] = append(r.byQuoteAsset[symbol.QuoteAsset], symbol)
+	}
+	r.nextRefresh = receivedAt.Add(r.interval)
+
+	return nil
+}
+
+// Symbol with the given name, like ETHBTC.
+func (r *SymbolRegistry) Symbol(name string) (Symbol, bool) {
+	r.refreshIfDue()
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	symbol, ok := r.bySymbol[name]
+	return symbol, ok
+}
+
+// All the symbols in the order sent by the api.
+func (r *SymbolRegistry) Symbols() []Symbol {
+	r.refreshIfDue()
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append([]Symbol(nil), r.symbols...)
+}
+
+// Symbols that trade the given asset, like ETH in ETHBTC.
+func (r *SymbolRegistry) SymbolsByBaseAsset(asset string) []Symbol {
+	r.refreshIfDue()
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append([]Symbol(nil), r.byBaseAsset[asset]...)
+}
+
+// Symbols priced in the given asset, like BTC in ETHBTC.
+func (r *SymbolRegistry) SymbolsByQuoteAsset(asset string) []Symbol {
+	r.refreshIfDue()
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append([]Symbol(nil), r.byQuoteAsset[asset]...)
+}
+
+// Validator of the orders of the given symbol with its current average price, requested to the api, so the percent
+// price filters and the notional of market orders are checked too. It returns ErrUnknownSymbol when the registry
+// has no such symbol.
+func (r *SymbolRegistry) OrderValidator(ctx context.Context, name string) (*OrderValidator, error) {
+	symbol, ok := r.Symbol(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSymbol, name)
+	}
+	return r.validator(ctx, symbol)
+}
+
+// Refreshes the symbols in the background when the error is a filter failure, since the filters of the symbol may
+// have changed. Other errors are ignored.
+func (r *SymbolRegistry) ReportError(err error) {
+	if !errors.Is(err, ErrFilterFailure) {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.nextRefresh = time.Time{}
+}
+
+// Starts a refresh in the background when it is due and there is none running.
+func (r *SymbolRegistry) refreshIfDue() {
+	r.mutex.RLock()
+	due := r.isRefreshDue()
+	r.mutex.RUnlock()
+	if !due {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.isRefreshDue() {
+		r.refreshing = true
+		go r.refreshInBackground()
+	}
+}
+
+func (r *SymbolRegistry) isRefreshDue() bool {
+	return !r.refreshing && !r.localTime().Before(r.nextRefresh)
+}
+
+func (r *SymbolRegistry) refreshInBackground() {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+
+	r.Refresh(ctx)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.refreshing = false
+}
@@ -0,0 +1,194 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// Waits until the refresh started by a lookup has finished.
+func waitForRefresh(t *testing.T, registry *SymbolRegistry) {
+	assert.Eventually(t, func() bool {
+		registry.mutex.RLock()
+		defer registry.mutex.RUnlock()
+
+		return !registry.refreshing
+	}, time.Second, time.Millisecond)
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	t.Run("It should load the symbols of the query in the background on the first lookup", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest("GET", "/api/v3/exchangeInfo").Param("permissions", `["SPOT"]`)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			Times(1).
+			Return(validExchangeInfoJson(), nil)
+
+		registry := NewSymbolRegistryFor(sdk, NewExchangeInfoQuery().Permissions("SPOT"), time.Hour)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		symbol, ok := registry.Symbol("ETHBTC")
+
+		assert.True(t, ok)
+		assert.Equal(t, validExchangeInfoResponse().Symbols[0], symbol)
+		assert.Equal(t, validExchangeInfoResponse().Symbols, registry.Symbols())
+	})
+
+	t.Run("It should index the symbols by asset", func(t *testing.T) {
+		registry := registryWithSymbols(&fakeLocalTime{}, new(int))
+		registry.Refresh(context.Background())
+
+		assert.Equal(t, []Symbol{registrySymbol("ETHBTC", "ETH", "BTC"), registrySymbol("ETHUSDT", "ETH", "USDT")},
+			registry.SymbolsByBaseAsset("ETH"))
+		assert.Equal(t, []Symbol{registrySymbol("ETHBTC", "ETH", "BTC"), registrySymbol("BNBBTC", "BNB", "BTC")},
+			registry.SymbolsByQuoteAsset("BTC"))
+		assert.Empty(t, registry.SymbolsByQuoteAsset("EUR"))
+
+		_, ok := registry.Symbol("BTCEUR")
+		assert.False(t, ok)
+	})
+
+	t.Run("It should return a validator with the filters and the average price of the symbol", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		registry := registryWithSymbols(&fakeLocalTime{}, new(int))
+		registry.validator = Sdk{client: mockedClient}.OrderValidatorCtx
+		registry.Refresh(context.Background())
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest("GET", "/api/v3/avgPrice").Param("symbol", "BNBBTC")).
+			Times(1).
+			Return(validAveragePriceJson(), nil)
+
+		validator, err := registry.OrderValidator(context.Background(), "BNBBTC")
+		assert.NoError(t, err)
+		assert.Equal(t, NewOrderValidator(registrySymbol("BNBBTC", "BNB", "BTC")).AveragePrice(MustDecimal("0.05")), validator)
+
+		_, err = registry.OrderValidator(context.Background(), "BTCEUR")
+		assert.True(t, errors.Is(err, ErrUnknownSymbol))
+		assert.EqualError(t, err, "unknown symbol: BTCEUR")
+	})
+
+	t.Run("It should refresh only when the interval has passed", func(t *testing.T) {
+		local, calls := &fakeLocalTime{now: time.Unix(1000, 0)}, 0
+		registry := registryWithSymbols(local, &calls)
+
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		local.Advance(30 * time.Minute)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 1, calls)
+
+		local.Advance(30 * time.Minute)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("It should refresh on the next lookup after a filter failure", func(t *testing.T) {
+		local, calls := &fakeLocalTime{now: time.Unix(1000, 0)}, 0
+		registry := registryWithSymbols(local, &calls)
+		registry.Refresh(context.Background())
+
+		registry.ReportError(errors.New("error"))
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 1, calls)
+
+		registry.ReportError(&APIError{Code: -1013, Message: "Filter failure: LOT_SIZE"})
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("It should keep the symbols and retry later when a refresh fails", func(t *testing.T) {
+		local, calls := &fakeLocalTime{now: time.Unix(1000, 0)}, 0
+		registry := registryWithSymbols(local, &calls)
+		registry.Refresh(context.Background())
+
+		registry.exchangeInfo = func(ctx context.Context) (*ExchangeInfo, error) {
+			calls++
+			return nil, errors.New("error")
+		}
+		local.Advance(time.Hour)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		_, ok := registry.Symbol("ETHBTC")
+		assert.True(t, ok)
+
+		local.Advance(time.Second)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 2, calls)
+
+		local.Advance(refreshRetryDelay)
+		registry.Symbol("ETHBTC")
+		waitForRefresh(t, registry)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("It should not block the lookups while it refreshes", func(t *testing.T) {
+		local, calls := &fakeLocalTime{now: time.Unix(1000, 0)}, 0
+		registry := registryWithSymbols(local, &calls)
+		registry.Refresh(context.Background())
+
+		release := make(chan struct{})
+		defer close(release)
+		registry.exchangeInfo = func(ctx context.Context) (*ExchangeInfo, error) {
+			<-release
+			return nil, errors.New("error")
+		}
+		local.Advance(time.Hour)
+
+		startedAt := time.Now()
+		_, ok := registry.Symbol("ETHBTC")
+		registry.Symbols()
+		registry.SymbolsByBaseAsset("ETH")
+
+		assert.True(t, ok)
+		assert.True(t, time.Since(startedAt) < 100*time.Millisecond)
+	})
+
+	t.Run("It should return the error of an explicit refresh", func(t *testing.T) {
+		registry := &SymbolRegistry{
+			localTime: time.Now,
+			exchangeInfo: func(ctx context.Context) (*ExchangeInfo, error) {
+				return nil, ctx.Err()
+			},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.Equal(t, context.Canceled, registry.Refresh(ctx))
+		assert.Empty(t, registry.bySymbol)
+	})
+}
+
+func registryWithSymbols(local *fakeLocalTime, calls *int) *SymbolRegistry {
+	return &SymbolRegistry{
+		localTime: local.Now,
+		interval:  time.Hour,
+		exchangeInfo: func(ctx context.Context) (*ExchangeInfo, error) {
+			*calls++
+			return &ExchangeInfo{Symbols: []Symbol{
+				registrySymbol("ETHBTC", "ETH", "BTC"),
+				registrySymbol("BNBBTC", "BNB", "BTC"),
+				registrySymbol("ETHUSDT", "ETH", "USDT"),
+			}}, nil
+		},
+	}
+}
+
+func registrySymbol(symbol string, baseAsset string, quoteAsset string) Symbol {
+	return Symbol{Symbol: symbol, BaseAsset: baseAsset, QuoteAsset: quoteAsset}
+}
@@ -0,0 +1,64 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Commission rates that would be charged for an order, returned by TestNewOrderCommissionRates.
+type OrderCommissionRates struct {
+	StandardCommissionForOrder CommissionRates    `json:"standardCommissionForOrder"`
+	TaxCommissionForOrder      CommissionRates    `json:"taxCommissionForOrder"`
+	Discount                   CommissionDiscount `json:"discount"`
+}
+
+type CommissionRates struct {
+	Maker Decimal `json:"maker"`
+	Taker Decimal `json:"taker"`
+}
+
+type CommissionDiscount struct {
+	EnabledForAccount bool    `json:"enabledForAccount"`
+	EnabledForSymbol  bool    `json:"enabledForSymbol"`
+	DiscountAsset     string  `json:"discountAsset"`
+	Discount          Decimal `json:"discount"`
+}
+
+// Validates a new order without sending it to the matching engine.
+func (sdk Sdk) TestNewOrder(request *newOrderRequest) error {
+	return sdk.TestNewOrderCtx(context.Background(), request)
+}
+
+func (sdk Sdk) TestNewOrderCtx(ctx context.Context, request *newOrderRequest) error {
+	if err := request.validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.client.Do(ctx, sdk.buildNewOrderRequest("/api/v3/order/test", request))
+	return err
+}
+
+// Validates a new order without sending it to the matching engine and returns the commission rates it would pay.
+func (sdk Sdk) TestNewOrderCommissionRates(request *newOrderRequest) (*OrderCommissionRates, error) {
+	return sdk.TestNewOrderCommissionRatesCtx(context.Background(), request)
+}
+
+func (sdk Sdk) TestNewOrderCommissionRatesCtx(ctx context.Context, request *newOrderRequest) (*OrderCommissionRates, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	req := sdk.buildNewOrderRequest("/api/v3/order/test", request).Param("computeCommissionRates", "true")
+	responseContent, err := sdk.client.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOrderCommissionRatesResponse(responseContent)
+}
+
+func parseOrderCommissionRatesResponse(jsonContent []byte) (*OrderCommissionRates, error) {
+	response := &OrderCommissionRates{}
+	err := json.Unmarshal(jsonContent, &response)
+	return response, err
+}
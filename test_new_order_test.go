@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSdk_TestNewOrder(t *testing.T) {
+	method, url := "POST", "/api/v3/order/test"
+
+	t.Run("It should send the order to the test endpoint", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "BUY").
+			Param("type", "LIMIT").
+			Param("quantity", "0.5").
+			Param("timeInForce", "GTC").
+			Param("price", "25000.1").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`{}`), nil)
+
+		request := NewOrderRequest("BTCUSDT", SideBuy, OrderTypeLimit, MustDecimal("0.5")).
+			Price(MustDecimal("25000.1")).
+			TimeInForce(TimeInForceGTC)
+
+		assert.NoError(t, sdk.TestNewOrder(request))
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		assert.Error(t, sdk.TestNewOrder(NewOrderRequest("BTCUSDT", SideBuy, OrderTypeMarket, MustDecimal("0.5"))))
+	})
+
+	t.Run("It should not call the api when the order is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		sdk := Sdk{client: NewMockClient(ctrl), clock: NewMockClock(ctrl)}
+
+		assert.Error(t, sdk.TestNewOrder(NewOrderRequest("BTCUSDT", "BUYY", OrderTypeMarket, MustDecimal("0.5"))))
+	})
+}
+
+func TestSdk_TestNewOrderCommissionRates(t *testing.T) {
+	method, url := "POST", "/api/v3/order/test"
+
+	t.Run("It should convert api response to an OrderCommissionRates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BTCUSDT").
+			Param("side", "BUY").
+			Param("type", "MARKET").
+			Param("quantity", "0.5").
+			Param("newOrderRespType", "FULL").
+			Param("timestamp", strconv.FormatInt(expectedTimestamp, 10)).
+			Param("computeCommissionRates", "true").
+			Sign()
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validOrderCommissionRatesJson(), nil)
+
+		request := NewOrderRequest("BTCUSDT", SideBuy, OrderTypeMarket, MustDecimal("0.5"))
+		response, _ := sdk.TestNewOrderCommissionRates(request)
+
+		assert.Equal(t, validOrderCommissionRatesResponse(), response)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockedClock := NewMockClock(ctrl)
+		mockedClient := NewMockClient(ctrl)
+		sdk := Sdk{client: mockedClient, clock: mockedClock}
+
+		expectedTimestamp := time.Now().Unix()
+		mockedClock.EXPECT().Now().Return(&expectedTimestamp)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.TestNewOrderCommissionRates(NewOrderRequest("BTCUSDT", SideBuy, OrderTypeMarket, MustDecimal("0.5")))
+
+		assert.Error(t, err)
+	})
+}
+
+func validOrderCommissionRatesJson() []byte {
+	return []byte(`{
+		"standardCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"taxCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.25000000"
+		}
+	}`)
+}
+
+func validOrderCommissionRatesResponse() *OrderCommissionRates {
+	return &OrderCommissionRates{
+		StandardCommissionForOrder: CommissionRates{Maker: MustDecimal("0.00000112"), Taker: MustDecimal("0.00000114")},
+		TaxCommissionForOrder:      CommissionRates{Maker: MustDecimal("0.00000112"), Taker: MustDecimal("0.00000114")},
+		Discount: CommissionDiscount{
+			EnabledForAccount: true,
+			EnabledForSymbol:  true,
+			DiscountAsset:     "BNB",
+			Discount:          MustDecimal("0.25"),
+		},
+	}
+}
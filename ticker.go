@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"context"
+	"errors"
+)
+
+var errTickerSymbolRequired = errors.New("symbol or symbols is required")
+
+type tickerQuery struct {
+	symbol     *string
+	symbols    []string
+	windowSize *string
+}
+
+// Query for the rolling window tickers. Symbol or Symbols are required.
+func NewTickerQuery() *tickerQuery {
+	return &tickerQuery{}
+}
+
+func (q *tickerQuery) Symbol(value string) *tickerQuery {
+	q.symbol = &value
+	return q
+}
+
+func (q *tickerQuery) Symbols(values ...string) *tickerQuery {
+	q.symbols = values
+	return q
+}
+
+// Size of the window, from 1m to 59m, 1h to 23h or 1d to 7d. It is 1d by default.
+func (q *tickerQuery) WindowSize(value string) *tickerQuery {
+	q.windowSize = &value
+	return q
+}
+
+func (q *tickerQuery) request() (*request, error) {
+	if q.symbol == nil && len(q.symbols) == 0 {
+		return nil, errTickerSymbolRequired
+	}
+
+	return newRequest("GET", "/api/v3/ticker").
+		StringParam("symbol", q.symbol).
+		StringsParam("symbols", q.symbols).
+		StringParam("windowSize", q.windowSize), nil
+}
+
+// Price change statistics in a rolling window of the given size. The window is precise to the minute, so it ends
+// up to a minute before the request. The weight is 4 per symbol, up to 200.
+func (sdk Sdk) Ticker(query *tickerQuery) ([]Ticker, error) {
+	return sdk.TickerCtx(context.Background(), query)
+}
+
+func (sdk Sdk) TickerCtx(ctx context.Context, query *tickerQuery) ([]Ticker, error) {
+	request, err := query.request()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]Ticker, 0)
+	if err := sdk.tickers(ctx, request, tickerTypeFull, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Same as Ticker with the MINI response type, which skips the price change.
+func (sdk Sdk) MiniTicker(query *tickerQuery) ([]MiniTicker, error) {
+	return sdk.MiniTickerCtx(context.Background(), query)
+}
+
+func (sdk Sdk) MiniTickerCtx(ctx context.Context, query *tickerQuery) ([]MiniTicker, error) {
+	request, err := query.request()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]MiniTicker, 0)
+	if err := sdk.tickers(ctx, request, tickerTypeMini, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type tradingDayTickerQuery struct {
+	symbol   *string
+	symbols  []string
+	timeZone *string
+}
+
+// Query for the trading day tickers. Symbol or Symbols are required.
+func NewTradingDayTickerQuery() *tradingDayTickerQuery {
+	return &tradingDayTickerQuery{}
+}
+
+func (q *tradingDayTickerQuery) Symbol(value string) *tradingDayTickerQuery {
+	q.symbol = &value
+	return q
+}
+
+func (q *tradingDayTickerQuery) Symbols(values ...string) *tradingDayTickerQuery {
+	q.symbols = values
+	return q
+}
+
+// Time zone where the trading day starts, as hours and minutes like "-1:00" or "05:45", or as hours like "8". It
+// is UTC by default.
+func (q *tradingDayTickerQuery) TimeZone(value string) *tradingDayTickerQuery {
+	q.timeZone = &value
+	return q
+}
+
+func (q *tradingDayTickerQuery) request() (*request, error) {
+	if q.symbol == nil && len(q.symbols) == 0 {
+		return nil, errTickerSymbolRequired
+	}
+
+	return newRequest("GET", "/api/v3/ticker/tradingDay").
+		StringParam("symbol", q.symbol).
+		StringsParam("symbols", q.symbols).
+		StringParam("timeZone", q.timeZone), nil
+}
+
+// Price change statistics since the start of the trading day in the given time zone. The weight is 4 per symbol, up
+// to 200.
+func (sdk Sdk) TradingDayTicker(query *tradingDayTickerQuery) ([]Ticker, error) {
+	return sdk.TradingDayTickerCtx(context.Background(), query)
+}
+
+func (sdk Sdk) TradingDayTickerCtx(ctx context.Context, query *tradingDayTickerQuery) ([]Ticker, error) {
+	request, err := query.request()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]Ticker, 0)
+	if err := sdk.tickers(ctx, request, tickerTypeFull, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Same as TradingDayTicker with the MINI response type, which skips the price change.
+func (sdk Sdk) MiniTradingDayTicker(query *tradingDayTickerQuery) ([]MiniTicker, error) {
+	return sdk.MiniTradingDayTickerCtx(context.Background(), query)
+}
+
+func (sdk Sdk) MiniTradingDayTickerCtx(ctx context.Context, query *tradingDayTickerQuery) ([]MiniTicker, error) {
+	request, err := query.request()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]MiniTicker, 0)
+	if err := sdk.tickers(ctx, request, tickerTypeMini, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
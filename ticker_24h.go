@@ -0,0 +1,125 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// Price statistics of a symbol in a window of time, sent with the MINI response type.
+type MiniTicker struct {
+	Symbol      string  `json:"symbol"`
+	OpenPrice   Decimal `json:"openPrice"`
+	HighPrice   Decimal `json:"highPrice"`
+	LowPrice    Decimal `json:"lowPrice"`
+	LastPrice   Decimal `json:"lastPrice"`
+	Volume      Decimal `json:"volume"`
+	QuoteVolume Decimal `json:"quoteVolume"`
+	OpenTime    int64   `json:"openTime"`
+	CloseTime   int64   `json:"closeTime"`
+	FirstId     int64   `json:"firstId"`
+	LastId      int64   `json:"lastId"`
+	Count       int64   `json:"count"`
+}
+
+// Price change statistics of a symbol in a window of time, sent with the FULL response type.
+type Ticker struct {
+	MiniTicker
+	PriceChange        Decimal `json:"priceChange"`
+	PriceChangePercent Decimal `json:"priceChangePercent"`
+	WeightedAvgPrice   Decimal `json:"weightedAvgPrice"`
+}
+
+// Price change statistics of a symbol in the last 24 hours, with the last trade and the best prices of the order
+// book.
+type Ticker24h struct {
+	Ticker
+	PrevClosePrice Decimal `json:"prevClosePrice"`
+	LastQuantity   Decimal `json:"lastQty"`
+	BidPrice       Decimal `json:"bidPrice"`
+	BidQuantity    Decimal `json:"bidQty"`
+	AskPrice       Decimal `json:"askPrice"`
+	AskQuantity    Decimal `json:"askQty"`
+}
+
+type ticker24hQuery struct {
+	symbol  *string
+	symbols []string
+}
+
+// Query for the tickers of all the symbols unless Symbol or Symbols are set.
+func NewTicker24hQuery() *ticker24hQuery {
+	return &ticker24hQuery{}
+}
+
+func (q *ticker24hQuery) Symbol(value string) *ticker24hQuery {
+	q.symbol = &value
+	return q
+}
+
+func (q *ticker24hQuery) Symbols(values ...string) *ticker24hQuery {
+	q.symbols = values
+	return q
+}
+
+// 24 hour rolling window price change statistics. The weight of the request grows with the number of symbols and
+// it is 80 for all the symbols.
+func (sdk Sdk) Ticker24h(query *ticker24hQuery) ([]Ticker24h, error) {
+	return sdk.Ticker24hCtx(context.Background(), query)
+}
+
+func (sdk Sdk) Ticker24hCtx(ctx context.Context, query *ticker24hQuery) ([]Ticker24h, error) {
+	response := make([]Ticker24h, 0)
+	if err := sdk.tickers(ctx, query.request(), tickerTypeFull, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Same as Ticker24h with the MINI response type, which skips the price change, the last trade and the order book.
+func (sdk Sdk) MiniTicker24h(query *ticker24hQuery) ([]MiniTicker, error) {
+	return sdk.MiniTicker24hCtx(context.Background(), query)
+}
+
+func (sdk Sdk) MiniTicker24hCtx(ctx context.Context, query *ticker24hQuery) ([]MiniTicker, error) {
+	response := make([]MiniTicker, 0)
+	if err := sdk.tickers(ctx, query.request(), tickerTypeMini, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (q *ticker24hQuery) request() *request {
+	return newRequest("GET", "/api/v3/ticker/24hr").
+		StringParam("symbol", q.symbol).
+		StringsParam("symbols", q.symbols)
+}
+
+// Response types of the tickers. FULL is the default, so it is not sent.
+const (
+	tickerTypeFull = "FULL"
+	tickerTypeMini = "MINI"
+)
+
+func (sdk Sdk) tickers(ctx context.Context, request *request, tickerType string, response interface{}) error {
+	if tickerType != tickerTypeFull {
+		request.Param("type", tickerType)
+	}
+
+	responseContent, err := sdk.client.Do(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return parseTickersResponse(responseContent, response)
+}
+
+// The tickers of a single symbol are sent as an object instead of an array, so they are decoded as an array of one
+// ticker.
+func parseTickersResponse(jsonContent []byte, response interface{}) error {
+	jsonContent = bytes.TrimSpace(jsonContent)
+	if len(jsonContent) > 0 && jsonContent[0] == '{' {
+		jsonContent = append(append([]byte{'['}, jsonContent...), ']')
+	}
+	return json.Unmarshal(jsonContent, response)
+}
@@ -0,0 +1,203 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSdk_Ticker24h(t *testing.T) {
+	method, url := "GET", "/api/v3/ticker/24hr"
+
+	t.Run("It should convert api response to a Ticker24h slice", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`[`+string(validTicker24hJson())+`]`), nil)
+
+		response, _ := sdk.Ticker24h(NewTicker24hQuery())
+
+		assert.Equal(t, []Ticker24h{validTicker24hResponse()}, response)
+	})
+
+	t.Run("It should convert the ticker of a single symbol to a slice", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "BNBBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validTicker24hJson(), nil)
+
+		response, _ := sdk.Ticker24h(NewTicker24hQuery().Symbol("BNBBTC"))
+
+		assert.Equal(t, []Ticker24h{validTicker24hResponse()}, response)
+	})
+
+	t.Run("It should send the symbols as a json array", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbols", `["BNBBTC","BTCUSDT"]`)
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`[`+string(validTicker24hJson())+`]`), nil)
+
+		response, _ := sdk.Ticker24h(NewTicker24hQuery().Symbols("BNBBTC", "BTCUSDT"))
+
+		assert.Equal(t, []Ticker24h{validTicker24hResponse()}, response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.Ticker24h(NewTicker24hQuery())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url)).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.Ticker24h(NewTicker24hQuery())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSdk_MiniTicker24h(t *testing.T) {
+	method, url := "GET", "/api/v3/ticker/24hr"
+
+	t.Run("It should request the MINI response type", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BNBBTC").
+			Param("type", "MINI")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validMiniTickerJson(), nil)
+
+		response, _ := sdk.MiniTicker24h(NewTicker24hQuery().Symbol("BNBBTC"))
+
+		assert.Equal(t, []MiniTicker{validMiniTickerResponse()}, response)
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("type", "MINI")).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.MiniTicker24h(NewTicker24hQuery())
+
+		assert.Error(t, err)
+	})
+}
+
+func validMiniTickerJson() []byte {
+	return []byte(`{
+		"symbol": "BNBBTC",
+		"openPrice": "99.00000000",
+		"highPrice": "100.00000000",
+		"lowPrice": "0.10000000",
+		"lastPrice": "4.00000200",
+		"volume": "8913.30000000",
+		"quoteVolume": "15.30000000",
+		"openTime": 1499783499040,
+		"closeTime": 1499869899040,
+		"firstId": 28385,
+		"lastId": 28460,
+		"count": 76
+	}`)
+}
+
+func validMiniTickerResponse() MiniTicker {
+	return MiniTicker{
+		Symbol:      "BNBBTC",
+		OpenPrice:   MustDecimal("99"),
+		HighPrice:   MustDecimal("100"),
+		LowPrice:    MustDecimal("0.1"),
+		LastPrice:   MustDecimal("4.000002"),
+		Volume:      MustDecimal("8913.3"),
+		QuoteVolume: MustDecimal("15.3"),
+		OpenTime:    1499783499040,
+		CloseTime:   1499869899040,
+		FirstId:     28385,
+		LastId:      28460,
+		Count:       76,
+	}
+}
+
+func validTicker24hJson() []byte {
+	return []byte(`{
+		"symbol": "BNBBTC",
+		"priceChange": "-94.99999800",
+		"priceChangePercent": "-95.960",
+		"weightedAvgPrice": "0.29628482",
+		"prevClosePrice": "0.10002000",
+		"lastPrice": "4.00000200",
+		"lastQty": "200.00000000",
+		"bidPrice": "4.00000000",
+		"bidQty": "100.00000000",
+		"askPrice": "4.00000200",
+		"askQty": "100.00000000",
+		"openPrice": "99.00000000",
+		"highPrice": "100.00000000",
+		"lowPrice": "0.10000000",
+		"volume": "8913.30000000",
+		"quoteVolume": "15.30000000",
+		"openTime": 1499783499040,
+		"closeTime": 1499869899040,
+		"firstId": 28385,
+		"lastId": 28460,
+		"count": 76
+	}`)
+}
+
+func validTicker24hResponse() Ticker24h {
+	return Ticker24h{
+		Ticker:         validTickerResponse(),
+		PrevClosePrice: MustDecimal("0.10002"),
+		LastQuantity:   MustDecimal("200"),
+		BidPrice:       MustDecimal("4"),
+		BidQuantity:    MustDecimal("100"),
+		AskPrice:       MustDecimal("4.000002"),
+		AskQuantity:    MustDecimal("100"),
+	}
+}
@@ -0,0 +1,201 @@
+package binance
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSdk_Ticker(t *testing.T) {
+	method, url := "GET", "/api/v3/ticker"
+
+	t.Run("It should convert api response to a Ticker slice", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "BNBBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validTickerJson(), nil)
+
+		response, _ := sdk.Ticker(NewTickerQuery().Symbol("BNBBTC"))
+
+		assert.Equal(t, []Ticker{validTickerResponse()}, response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).
+			Param("symbols", `["BNBBTC","BTCUSDT"]`).
+			Param("windowSize", "4h")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`[`+string(validTickerJson())+`]`), nil)
+
+		response, _ := sdk.Ticker(NewTickerQuery().Symbols("BNBBTC", "BTCUSDT").WindowSize("4h"))
+
+		assert.Equal(t, []Ticker{validTickerResponse()}, response)
+	})
+
+	t.Run("It should request the MINI response type", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).
+			Param("symbol", "BNBBTC").
+			Param("type", "MINI")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validMiniTickerJson(), nil)
+
+		response, _ := sdk.MiniTicker(NewTickerQuery().Symbol("BNBBTC"))
+
+		assert.Equal(t, []MiniTicker{validMiniTickerResponse()}, response)
+	})
+
+	t.Run("It should return error when there are no symbols", func(t *testing.T) {
+		sdk := Sdk{client: NewMockClient(gomock.NewController(t))}
+
+		_, err := sdk.Ticker(NewTickerQuery())
+		assert.EqualError(t, err, "symbol or symbols is required")
+
+		_, err = sdk.MiniTicker(NewTickerQuery().WindowSize("1h"))
+		assert.EqualError(t, err, "symbol or symbols is required")
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("symbol", "BNBBTC")).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.Ticker(NewTickerQuery().Symbol("BNBBTC"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("It should return error when response cannot be mapped", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("symbol", "BNBBTC")).
+			MinTimes(1).
+			Return(invalidJson(), nil)
+
+		_, err := sdk.Ticker(NewTickerQuery().Symbol("BNBBTC"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSdk_TradingDayTicker(t *testing.T) {
+	method, url := "GET", "/api/v3/ticker/tradingDay"
+
+	t.Run("It should convert api response to a Ticker slice", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).Param("symbol", "BNBBTC")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return(validTickerJson(), nil)
+
+		response, _ := sdk.TradingDayTicker(NewTradingDayTickerQuery().Symbol("BNBBTC"))
+
+		assert.Equal(t, []Ticker{validTickerResponse()}, response)
+	})
+
+	t.Run("It should read optional parameters", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		expectedRequest := newRequest(method, url).
+			Param("symbols", `["BNBBTC","BTCUSDT"]`).
+			Param("timeZone", "-1:00").
+			Param("type", "MINI")
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), expectedRequest).
+			MinTimes(1).
+			Return([]byte(`[`+string(validMiniTickerJson())+`]`), nil)
+
+		query := NewTradingDayTickerQuery().Symbols("BNBBTC", "BTCUSDT").TimeZone("-1:00")
+		response, _ := sdk.MiniTradingDayTicker(query)
+
+		assert.Equal(t, []MiniTicker{validMiniTickerResponse()}, response)
+	})
+
+	t.Run("It should return error when there are no symbols", func(t *testing.T) {
+		sdk := Sdk{client: NewMockClient(gomock.NewController(t))}
+
+		_, err := sdk.TradingDayTicker(NewTradingDayTickerQuery().TimeZone("8"))
+
+		assert.EqualError(t, err, "symbol or symbols is required")
+	})
+
+	t.Run("It should return error when api fails", func(t *testing.T) {
+		mockedClient := NewMockClient(gomock.NewController(t))
+		sdk := Sdk{client: mockedClient}
+
+		mockedClient.
+			EXPECT().
+			Do(gomock.Any(), newRequest(method, url).Param("symbol", "BNBBTC")).
+			MinTimes(1).
+			Return(nil, errors.New("error"))
+
+		_, err := sdk.TradingDayTicker(NewTradingDayTickerQuery().Symbol("BNBBTC"))
+
+		assert.Error(t, err)
+	})
+}
+
+func validTickerJson() []byte {
+	return []byte(`{
+		"symbol": "BNBBTC",
+		"priceChange": "-94.99999800",
+		"priceChangePercent": "-95.960",
+		"weightedAvgPrice": "0.29628482",
+		"openPrice": "99.00000000",
+		"highPrice": "100.00000000",
+		"lowPrice": "0.10000000",
+		"lastPrice": "4.00000200",
+		"volume": "8913.30000000",
+		"quoteVolume": "15.30000000",
+		"openTime": 1499783499040,
+		"closeTime": 1499869899040,
+		"firstId": 28385,
+		"lastId": 28460,
+		"count": 76
+	}`)
+}
+
+func validTickerResponse() Ticker {
+	return Ticker{
+		MiniTicker:         validMiniTickerResponse(),
+		PriceChange:        MustDecimal("-94.999998"),
+		PriceChangePercent: MustDecimal("-95.96"),
+		WeightedAvgPrice:   MustDecimal("0.29628482"),
+	}
+}
@@ -1,14 +1,15 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
 
 type Trade struct {
 	Id           int
-	Price        float64 `json:"price,string"`
-	Quantity     float64 `json:"qty,string"`
+	Price        Decimal `json:"price"`
+	Quantity     Decimal `json:"qty"`
 	Time         int     `json:"time"`
 	IsBuyerMaker bool    `json:"isBuyerMaker"`
 	IsBestMatch  bool    `json:"isBestMatch"`
@@ -47,6 +48,10 @@ func parseTradesResponse(jsonContent []byte) ([]Trade, error) {
 }
 
 func (sdk *Sdk) Trades(query *tradesQuery) ([]Trade, error) {
+	return sdk.TradesCtx(context.Background(), query)
+}
+
+func (sdk *Sdk) TradesCtx(ctx context.Context, query *tradesQuery) ([]Trade, error) {
 	request := newRequest("GET", "/api/v1/historicalTrades").
 		Param("symbol", query.symbol).
 		Param("limit", strconv.Itoa(query.limit))
@@ -55,7 +60,7 @@ func (sdk *Sdk) Trades(query *tradesQuery) ([]Trade, error) {
 		request.Param("fromId", strconv.Itoa(query.fromId))
 	}
 
-	responseContent, err := sdk.client.Do(request)
+	responseContent, err := sdk.client.Do(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +20,7 @@ func TestSdk_Trades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validTradesJson(), nil)
 
@@ -40,7 +40,7 @@ func TestSdk_Trades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validTradesJson(), nil)
 
@@ -60,7 +60,7 @@ func TestSdk_Trades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(validTradesJson(), errors.New("error"))
 		_, err := sdk.Trades(NewTradesQuery("ETHBTC"))
@@ -78,7 +78,7 @@ func TestSdk_Trades(t *testing.T) {
 
 		mockedClient.
 			EXPECT().
-			Do(expectedRequest).
+			Do(gomock.Any(), expectedRequest).
 			MinTimes(1).
 			Return(invalidJson(), nil)
 
@@ -104,8 +104,8 @@ func validTradesJson() []byte {
 func validTradesResponse() []Trade {
 	return []Trade{{
 		Id:           28457,
-		Price:        4.000001,
-		Quantity:     12.0,
+		Price:        MustDecimal("4.000001"),
+		Quantity:     MustDecimal("12.0"),
 		Time:         1499865549590,
 		IsBuyerMaker: true,
 		IsBestMatch:  true,